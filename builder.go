@@ -0,0 +1,119 @@
+package goreferrer
+
+import (
+	"slices"
+)
+
+// RuleSetBuilder collects rules to compile into a Classifier. The rule set it
+// starts from is only copied on the first change, and rule sets handed out by
+// RuleSet are never changed afterwards. A builder is not safe for concurrent
+// use, the classifiers it builds are.
+type RuleSetBuilder struct {
+	rules  RuleSet
+	shared bool
+}
+
+func NewRuleSetBuilder(base RuleSet) *RuleSetBuilder {
+	return &RuleSetBuilder{rules: base, shared: true}
+}
+
+func (b *RuleSetBuilder) SetDomainRule(key string, rule DomainRule) *RuleSetBuilder {
+	b.own()
+	b.rules.DomainRules[key] = rule
+	return b
+}
+
+func (b *RuleSetBuilder) SetUaRule(key string, rule UaRule) *RuleSetBuilder {
+	b.own()
+	b.rules.UaRules[key] = rule
+	return b
+}
+
+func (b *RuleSetBuilder) SetRedirectRule(key string, rule RedirectRule) *RuleSetBuilder {
+	b.own()
+	b.rules.RedirectRules[key] = rule
+	return b
+}
+
+func (b *RuleSetBuilder) SetAppRule(key string, rule AppRule) *RuleSetBuilder {
+	b.own()
+	b.rules.AppRules[key] = rule
+	return b
+}
+
+func (b *RuleSetBuilder) SetVerticalRule(key string, rule VerticalRule) *RuleSetBuilder {
+	b.own()
+	b.rules.VerticalRules[key] = rule
+	return b
+}
+
+func (b *RuleSetBuilder) DeleteDomainRule(key string) *RuleSetBuilder {
+	b.own()
+	delete(b.rules.DomainRules, key)
+	return b
+}
+
+func (b *RuleSetBuilder) DeleteUaRule(key string) *RuleSetBuilder {
+	b.own()
+	delete(b.rules.UaRules, key)
+	return b
+}
+
+func (b *RuleSetBuilder) DeleteRedirectRule(key string) *RuleSetBuilder {
+	b.own()
+	delete(b.rules.RedirectRules, key)
+	return b
+}
+
+func (b *RuleSetBuilder) DeleteAppRule(key string) *RuleSetBuilder {
+	b.own()
+	delete(b.rules.AppRules, key)
+	return b
+}
+
+func (b *RuleSetBuilder) DeleteVerticalRule(key string) *RuleSetBuilder {
+	b.own()
+	delete(b.rules.VerticalRules, key)
+	return b
+}
+
+// Merge adds the rules of other, replacing rules with the same key.
+func (b *RuleSetBuilder) Merge(other RuleSet) *RuleSetBuilder {
+	b.own()
+	b.rules.Merge(other)
+	return b
+}
+
+// RuleSet returns the rules collected so far. The builder copies them before
+// its next change, so the returned rule set stays as it is.
+func (b *RuleSetBuilder) RuleSet() RuleSet {
+	b.shared = true
+	return b.rules
+}
+
+func (b *RuleSetBuilder) Build() *Classifier {
+	return b.rules.Compile()
+}
+
+func (b *RuleSetBuilder) own() {
+	if !b.shared {
+		return
+	}
+
+	rules := NewRuleSet()
+	rules.Merge(b.rules)
+	b.rules = rules
+	b.shared = false
+}
+
+func (d DomainRule) clone() DomainRule {
+	d.Parameters = slices.Clone(d.Parameters)
+	d.PaidPaths = slices.Clone(d.PaidPaths)
+	d.PaidParameters = slices.Clone(d.PaidParameters)
+	return d
+}
+
+func (r RedirectRule) clone() RedirectRule {
+	r.Parameters = slices.Clone(r.Parameters)
+	return r
+}
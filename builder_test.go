@@ -0,0 +1,85 @@
+package goreferrer
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleSetBuilderCopiesOnWrite(t *testing.T) {
+	base := NewRuleSet()
+	base.DomainRules["zambo.com"] = DomainRule{Type: Search, Label: "Zambo"}
+
+	builder := NewRuleSetBuilder(base).
+		SetDomainRule("walrus.com", DomainRule{Type: Social, Label: "Walrus"}).
+		DeleteDomainRule("zambo.com")
+	assert.Equal(t, map[string]DomainRule{"zambo.com": {Type: Search, Label: "Zambo"}}, base.DomainRules)
+
+	rules := builder.RuleSet()
+	builder.SetDomainRule("zambo.com", DomainRule{Type: Email, Label: "Zambo Mail"})
+	assert.Equal(t, map[string]DomainRule{"walrus.com": {Type: Social, Label: "Walrus"}}, rules.DomainRules)
+
+	classifier := builder.Build()
+	assert.Equal(t, "Walrus", classifier.Parse("http://walrus.com").Label)
+	assert.Equal(t, "Zambo Mail", classifier.Parse("http://zambo.com").Label)
+}
+
+func TestRuleSetBuilderMerge(t *testing.T) {
+	classifier := NewRuleSetBuilder(DefaultRules).
+		Merge(RuleSet{DomainRules: map[string]DomainRule{"twitter.com": {Type: Search, Label: "Twitter Search"}}}).
+		SetUaRule("Zambo", UaRule{Url: "zambo://zambo.com", App: "Zambo"}).
+		SetRedirectRule("go.zambo.com", RedirectRule{Label: "Zambo", Parameters: []string{"to"}}).
+		SetAppRule("com.zambo.android", AppRule{Type: Social, Label: "Zambo"}).
+		DeleteAppRule("com.facebook.katana").
+		Build()
+
+	assert.Equal(t, Search, classifier.Parse("https://twitter.com/search?q=boots").Type)
+	assert.Equal(t, Social, DefaultClassifier.Parse("https://twitter.com/search?q=boots").Type)
+	assert.Equal(t, "Zambo", classifier.ParseWith("", nil, "Mozilla/5.0 Zambo/1.0").App)
+	assert.Equal(t, "https://shop.example.com/", classifier.Parse("http://go.zambo.com/?to=https://shop.example.com/").UnwrappedURL)
+	assert.Equal(t, "Zambo", classifier.Parse("android-app://com.zambo.android/").Label)
+	assert.Equal(t, FallbackMatch, classifier.Parse("android-app://com.facebook.katana/").Match.Kind)
+	assert.Equal(t, AppRuleMatch, DefaultClassifier.Parse("android-app://com.facebook.katana/").Match.Kind)
+}
+
+func TestClassifierCopiesRules(t *testing.T) {
+	params := []string{"q"}
+	builder := NewRuleSetBuilder(RuleSet{}).SetDomainRule("zambo.com", DomainRule{Type: Search, Parameters: params})
+	classifier := builder.Build()
+
+	params[0] = "other"
+	assert.Equal(t, "boots", classifier.Parse("http://zambo.com/?q=boots").Query)
+}
+
+func TestAtomicClassifierSwap(t *testing.T) {
+	var active AtomicClassifier
+	assert.Equal(t, DefaultClassifier, active.Load())
+
+	zambo := NewRuleSetBuilder(RuleSet{}).SetDomainRule("zambo.com", DomainRule{Type: Search, Label: "Zambo"}).Build()
+	walrus := NewRuleSetBuilder(RuleSet{}).SetDomainRule("zambo.com", DomainRule{Type: Search, Label: "Walrus"}).Build()
+	active.Store(zambo)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				label := active.Parse("http://zambo.com").Label
+				assert.Contains(t, []string{"Zambo", "Walrus"}, label)
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			active.Store(walrus)
+		} else {
+			active.Store(zambo)
+		}
+	}
+	wg.Wait()
+
+	assert.Equal(t, zambo, active.Swap(walrus))
+	assert.Equal(t, "Walrus", active.Parse("http://zambo.com").Label)
+}
@@ -0,0 +1,252 @@
+package goreferrer
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+)
+
+type Channel int
+
+const (
+	ChannelUnassigned Channel = iota
+	ChannelDirect
+	ChannelCrossNetwork
+	ChannelPaidShopping
+	ChannelPaidSearch
+	ChannelPaidSocial
+	ChannelPaidVideo
+	ChannelDisplay
+	ChannelPaidOther
+	ChannelOrganicShopping
+	ChannelOrganicSocial
+	ChannelOrganicVideo
+	ChannelOrganicSearch
+	ChannelReferral
+	ChannelEmail
+	ChannelAffiliates
+	ChannelAudio
+	ChannelSMS
+	ChannelMobilePushNotifications
+)
+
+func (c Channel) String() string {
+	switch c {
+	default:
+		return "Unassigned"
+	case ChannelDirect:
+		return "Direct"
+	case ChannelCrossNetwork:
+		return "Cross-network"
+	case ChannelPaidShopping:
+		return "Paid Shopping"
+	case ChannelPaidSearch:
+		return "Paid Search"
+	case ChannelPaidSocial:
+		return "Paid Social"
+	case ChannelPaidVideo:
+		return "Paid Video"
+	case ChannelDisplay:
+		return "Display"
+	case ChannelPaidOther:
+		return "Paid Other"
+	case ChannelOrganicShopping:
+		return "Organic Shopping"
+	case ChannelOrganicSocial:
+		return "Organic Social"
+	case ChannelOrganicVideo:
+		return "Organic Video"
+	case ChannelOrganicSearch:
+		return "Organic Search"
+	case ChannelReferral:
+		return "Referral"
+	case ChannelEmail:
+		return "Email"
+	case ChannelAffiliates:
+		return "Affiliates"
+	case ChannelAudio:
+		return "Audio"
+	case ChannelSMS:
+		return "SMS"
+	case ChannelMobilePushNotifications:
+		return "Mobile Push Notifications"
+	}
+}
+
+type SourceCategory int
+
+const (
+	NoSourceCategory SourceCategory = iota
+	SearchSource
+	SocialSource
+	ShoppingSource
+	VideoSource
+)
+
+// ChannelRules holds the source categories and medium lists used by the
+// Google Analytics 4 default channel group definitions.
+type ChannelRules struct {
+	Sources map[string]SourceCategory
+	Mediums map[string][]string
+}
+
+var (
+	paidMediumPattern       = regexp.MustCompile(`^(.*cp.*|ppc|retargeting|paid.*)$`)
+	shoppingCampaignPattern = regexp.MustCompile(`^(.*(([^a-df-z]|^)shop|shopping).*)$`)
+	videoMediumPattern      = regexp.MustCompile(`^(.*video.*)$`)
+)
+
+func ChannelGroup(ref Referrer) Channel {
+	return DefaultChannelRules.Group(ref)
+}
+
+// Group follows the order of the GA4 default channel group rules, the first
+// matching channel wins.
+func (c ChannelRules) Group(ref Referrer) Channel {
+	source, medium, campaign, fromReferrer := trafficSource(ref)
+	category := c.sourceCategory(ref, source, fromReferrer)
+	paid := paidMediumPattern.MatchString(medium)
+	shoppingCampaign := shoppingCampaignPattern.MatchString(campaign)
+
+	switch {
+	case source == "(direct)" && (medium == "(none)" || medium == "(not set)"):
+		return ChannelDirect
+	case strings.Contains(campaign, "cross-network"):
+		return ChannelCrossNetwork
+	case (category == ShoppingSource || shoppingCampaign) && paid:
+		return ChannelPaidShopping
+	case category == SearchSource && paid:
+		return ChannelPaidSearch
+	case category == SocialSource && paid:
+		return ChannelPaidSocial
+	case category == VideoSource && paid:
+		return ChannelPaidVideo
+	case c.mediumIs("display", medium):
+		return ChannelDisplay
+	case paid:
+		return ChannelPaidOther
+	case category == ShoppingSource || shoppingCampaign:
+		return ChannelOrganicShopping
+	case category == SocialSource || c.mediumIs("social", medium):
+		return ChannelOrganicSocial
+	case category == VideoSource || videoMediumPattern.MatchString(medium):
+		return ChannelOrganicVideo
+	case category == SearchSource || medium == "organic":
+		return ChannelOrganicSearch
+	case c.mediumIs("referral", medium):
+		return ChannelReferral
+	case c.mediumIs("email", source) || c.mediumIs("email", medium):
+		return ChannelEmail
+	case c.mediumIs("affiliate", medium):
+		return ChannelAffiliates
+	case c.mediumIs("audio", medium):
+		return ChannelAudio
+	case source == "sms" || c.mediumIs("sms", medium):
+		return ChannelSMS
+	case strings.HasSuffix(medium, "push") || strings.Contains(medium, "mobile") ||
+		strings.Contains(medium, "notification") || source == "firebase":
+		return ChannelMobilePushNotifications
+	}
+
+	return ChannelUnassigned
+}
+
+// trafficSource derives a GA style source, medium and campaign name. Landing
+// page campaign parameters take precedence over the referrer.
+func trafficSource(ref Referrer) (source, medium, campaign string, fromReferrer bool) {
+	campaign = strings.ToLower(ref.Campaign.Name)
+	if ref.Campaign.Source != "" || ref.Campaign.Medium != "" {
+		source = strings.ToLower(ref.Campaign.Source)
+		medium = strings.ToLower(ref.Campaign.Medium)
+		if source == "" {
+			source = "(not set)"
+		}
+		if medium == "" {
+			medium = "(not set)"
+		}
+		return source, medium, campaign, false
+	}
+
+	switch ref.Type {
+	case Direct:
+		return "(direct)", "(none)", campaign, true
+	case Invalid:
+		return "(not set)", "(not set)", campaign, true
+	case Search:
+		source, medium = strings.ToLower(ref.Domain), "organic"
+	default:
+		source, medium = strings.ToLower(ref.Host()), "referral"
+	}
+	if ref.Paid {
+		medium = "cpc"
+	}
+
+	return source, medium, campaign, true
+}
+
+func (c ChannelRules) sourceCategory(ref Referrer, source string, fromReferrer bool) SourceCategory {
+	candidates := []string{source}
+	if fromReferrer {
+		candidates = append(candidates, strings.ToLower(ref.RegisteredDomain()))
+	}
+
+	for _, candidate := range candidates {
+		if category, ok := c.Sources[candidate]; ok && candidate != "" {
+			return category
+		}
+	}
+
+	if fromReferrer {
+		switch ref.Type {
+		case Search:
+			return SearchSource
+		case Social:
+			return SocialSource
+		}
+	}
+
+	return NoSourceCategory
+}
+
+func (c ChannelRules) mediumIs(class, medium string) bool {
+	return containsString(c.Mediums[class], medium)
+}
+
+type jsonChannelRules struct {
+	Sources map[string][]string
+	Mediums map[string][]string
+}
+
+func LoadJsonChannelRules(reader io.Reader) (ChannelRules, error) {
+	var decoded jsonChannelRules
+	if err := json.NewDecoder(reader).Decode(&decoded); err != nil {
+		return ChannelRules{}, err
+	}
+
+	rules := ChannelRules{
+		Sources: make(map[string]SourceCategory),
+		Mediums: make(map[string][]string),
+	}
+	categories := []struct {
+		name     string
+		category SourceCategory
+	}{
+		{"search", SearchSource},
+		{"social", SocialSource},
+		{"shopping", ShoppingSource},
+		{"video", VideoSource},
+	}
+	for _, c := range categories {
+		for _, source := range decoded.Sources[c.name] {
+			rules.Sources[strings.ToLower(source)] = c.category
+		}
+	}
+	for class, mediums := range decoded.Mediums {
+		for _, medium := range mediums {
+			rules.Mediums[class] = append(rules.Mediums[class], strings.ToLower(medium))
+		}
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,89 @@
+package goreferrer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelGroupFromReferrer(t *testing.T) {
+	cases := []struct {
+		url      string
+		expected Channel
+	}{
+		{"", ChannelDirect},
+		{"https://www.google.com/", ChannelOrganicSearch},
+		{"http://www.google.ca/aclk?sa=l&ai=Cp3RJ8ri", ChannelPaidSearch},
+		{"https://search.yahoo.com/search?p=hello", ChannelOrganicSearch},
+		{"https://l.facebook.com/l.php?u=https://example.com", ChannelOrganicSocial},
+		{"https://puppyanimalbarn.tumblr.com", ChannelOrganicSocial},
+		{"https://www.youtube.com/watch?v=123", ChannelOrganicVideo},
+		{"https://www.etsy.com/shop/walrus", ChannelOrganicShopping},
+		{"http://walrus.com/", ChannelReferral},
+		{"https://mail.google.com/9aifaufasodf8usafd", ChannelReferral},
+		{"blap", ChannelUnassigned},
+	}
+
+	for _, c := range cases {
+		actual := ChannelGroup(DefaultRules.Parse(c.url))
+		if !assert.Equal(t, c.expected, actual) {
+			t.Log(c.url)
+		}
+	}
+}
+
+func TestChannelGroupFromCampaign(t *testing.T) {
+	cases := []struct {
+		landing  string
+		expected Channel
+	}{
+		{"https://shop.example.com/?utm_source=google&utm_medium=cpc", ChannelPaidSearch},
+		{"https://shop.example.com/?utm_source=facebook&utm_medium=paid_social", ChannelPaidSocial},
+		{"https://shop.example.com/?utm_source=facebook&utm_medium=social", ChannelOrganicSocial},
+		{"https://shop.example.com/?utm_source=youtube&utm_medium=cpv", ChannelPaidVideo},
+		{"https://shop.example.com/?utm_source=partner&utm_medium=cpc&utm_campaign=summer-shopping", ChannelPaidShopping},
+		{"https://shop.example.com/?utm_source=partner&utm_medium=cpc&utm_campaign=pmax-cross-network", ChannelCrossNetwork},
+		{"https://shop.example.com/?utm_source=dv360&utm_medium=banner", ChannelDisplay},
+		{"https://shop.example.com/?utm_source=partner&utm_medium=paid", ChannelPaidOther},
+		{"https://shop.example.com/?utm_source=newsletter&utm_medium=email", ChannelEmail},
+		{"https://shop.example.com/?utm_source=email", ChannelEmail},
+		{"https://shop.example.com/?utm_source=partner&utm_medium=affiliate", ChannelAffiliates},
+		{"https://shop.example.com/?utm_source=podcast&utm_medium=audio", ChannelAudio},
+		{"https://shop.example.com/?utm_source=sms", ChannelSMS},
+		{"https://shop.example.com/?utm_source=app&utm_medium=web_push", ChannelMobilePushNotifications},
+		{"https://shop.example.com/?utm_source=firebase&utm_medium=notif", ChannelMobilePushNotifications},
+		{"https://shop.example.com/?utm_source=blog&utm_medium=referral", ChannelReferral},
+		{"https://shop.example.com/?utm_source=walrus&utm_medium=organic", ChannelOrganicSearch},
+		{"https://shop.example.com/?utm_source=walrus&utm_medium=product_video", ChannelOrganicVideo},
+		{"https://shop.example.com/?utm_source=walrus&utm_medium=walrus", ChannelUnassigned},
+	}
+
+	for _, c := range cases {
+		actual := ChannelGroup(DefaultRules.ParseWithLanding("", c.landing, nil, ""))
+		if !assert.Equal(t, c.expected, actual) {
+			t.Log(c.landing)
+		}
+	}
+}
+
+func TestChannelGroupFromClickID(t *testing.T) {
+	ref := DefaultRules.ParseWithLanding("", "https://shop.example.com/?gclid=abc123", nil, "")
+	assert.Equal(t, ChannelPaidSearch, ChannelGroup(ref))
+}
+
+func TestChannelString(t *testing.T) {
+	assert.Equal(t, "Organic Search", ChannelOrganicSearch.String())
+	assert.Equal(t, "Mobile Push Notifications", ChannelMobilePushNotifications.String())
+	assert.Equal(t, "Unassigned", ChannelUnassigned.String())
+}
+
+func TestLoadJsonChannelRules(t *testing.T) {
+	rules, err := LoadJsonChannelRules(strings.NewReader(`{"sources": {"social": ["Mastodon"]}, "mediums": {"referral": ["Link"]}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, SocialSource, rules.Sources["mastodon"])
+	assert.Equal(t, []string{"link"}, rules.Mediums["referral"])
+
+	ref := DefaultRules.ParseWithLanding("", "https://shop.example.com/?utm_source=mastodon&utm_medium=toot", nil, "")
+	assert.Equal(t, ChannelOrganicSocial, rules.Group(ref))
+}
@@ -0,0 +1,441 @@
+package goreferrer
+
+import (
+	"maps"
+	"path"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Classifier is an immutable, compiled form of a RuleSet. Domain, redirect and
+// vertical rules are stored in a trie of host labels walked from the public
+// suffix inwards, each host holding a tree of path segments, so a lookup
+// neither builds candidate keys nor probes a map per key. It matches exactly like the
+// RuleSet it was compiled from and is safe for concurrent use.
+type Classifier struct {
+	domainRules   *ruleTrie[DomainRule]
+	redirectRules *ruleTrie[RedirectRule]
+	uaRules       []uaRuleEntry
+	appRules      map[string]AppRule
+	verticalRules *ruleTrie[VerticalRule]
+}
+
+type uaRuleEntry struct {
+	Key  string
+	Rule UaRule
+}
+
+// Compile builds a Classifier from the rule set. The rules are copied, so
+// later changes to the rule set are not reflected in the Classifier.
+func (r RuleSet) Compile() *Classifier {
+	c := &Classifier{
+		domainRules:   compileRuleTrie(r.DomainRules),
+		redirectRules: compileRuleTrie(r.RedirectRules),
+		appRules:      maps.Clone(r.AppRules),
+		verticalRules: compileRuleTrie(r.VerticalRules),
+	}
+	for _, key := range sortedUaRuleKeys(r.UaRules) {
+		c.uaRules = append(c.uaRules, uaRuleEntry{Key: key, Rule: r.UaRules[key]})
+	}
+
+	return c
+}
+
+func (c *Classifier) Parse(URL string) Referrer {
+	return c.ParseWith(URL, nil, "")
+}
+
+func (c *Classifier) ParseWith(URL string, domains []string, agent string) Referrer {
+	ref, _ := parseWith(c, URL, domains, agent)
+	return ref
+}
+
+// ParseE is Parse also returning a *ParseError when the referrer is Invalid.
+func (c *Classifier) ParseE(URL string) (Referrer, error) {
+	return c.ParseWithE(URL, nil, "")
+}
+
+func (c *Classifier) ParseWithE(URL string, domains []string, agent string) (Referrer, error) {
+	return parseWith(c, URL, domains, agent)
+}
+
+func (c *Classifier) ParseWithLanding(URL, landing string, domains []string, agent string) Referrer {
+	return parseWithLanding(c, URL, landing, domains, agent)
+}
+
+// AtomicClassifier publishes a Classifier to concurrent readers, so a new
+// rule version can be swapped in without locking the parsing path. The zero
+// value uses DefaultClassifier until a Classifier is stored.
+type AtomicClassifier struct {
+	classifier atomic.Pointer[Classifier]
+}
+
+func NewAtomicClassifier(c *Classifier) *AtomicClassifier {
+	a := &AtomicClassifier{}
+	a.Store(c)
+	return a
+}
+
+func (a *AtomicClassifier) Load() *Classifier {
+	if c := a.classifier.Load(); c != nil {
+		return c
+	}
+
+	return DefaultClassifier
+}
+
+func (a *AtomicClassifier) Store(c *Classifier) {
+	a.classifier.Store(c)
+}
+
+// Swap stores c and returns the previously stored Classifier, if any.
+func (a *AtomicClassifier) Swap(c *Classifier) *Classifier {
+	return a.classifier.Swap(c)
+}
+
+func (a *AtomicClassifier) Parse(URL string) Referrer {
+	return a.Load().Parse(URL)
+}
+
+func (a *AtomicClassifier) ParseWith(URL string, domains []string, agent string) Referrer {
+	return a.Load().ParseWith(URL, domains, agent)
+}
+
+func (a *AtomicClassifier) ParseE(URL string) (Referrer, error) {
+	return a.Load().ParseE(URL)
+}
+
+func (a *AtomicClassifier) ParseWithE(URL string, domains []string, agent string) (Referrer, error) {
+	return a.Load().ParseWithE(URL, domains, agent)
+}
+
+func (a *AtomicClassifier) ParseWithLanding(URL, landing string, domains []string, agent string) Referrer {
+	return a.Load().ParseWithLanding(URL, landing, domains, agent)
+}
+
+func (c *Classifier) getDomainRule(u *richUrl) (DomainRule, ruleKey, bool) {
+	return c.domainRules.find(u)
+}
+
+func (c *Classifier) getRedirectRule(u *richUrl) (RedirectRule, bool) {
+	rule, _, exists := c.redirectRules.find(u)
+	return rule, exists
+}
+
+func (c *Classifier) getVerticalRule(u *richUrl) (VerticalRule, bool) {
+	rule, _, exists := c.verticalRules.find(u)
+	return rule, exists
+}
+
+func (c *Classifier) getAppRule(appID string) (AppRule, bool) {
+	rule, exists := c.appRules[appID]
+	return rule, exists
+}
+
+func (c *Classifier) getUaRule(agent string) (string, UaRule) {
+	if agent == "" {
+		return "", UaRule{}
+	}
+
+	for _, entry := range c.uaRules {
+		if entry.Rule.matches(entry.Key, agent) {
+			return entry.Key, entry.Rule
+		}
+	}
+
+	return "", UaRule{}
+}
+
+// uaLiterals caches the required literals of user agent patterns, which are
+// checked before running the regexp since most agents contain none of them.
+var uaLiterals sync.Map
+
+func patternLiterals(pattern *regexp.Regexp) []string {
+	if literals, ok := uaLiterals.Load(pattern); ok {
+		return literals.([]string)
+	}
+
+	var literals []string
+	if re, err := syntax.Parse(pattern.String(), syntax.Perl); err == nil {
+		literals = requiredLiterals(re)
+	}
+	uaLiterals.Store(pattern, literals)
+	return literals
+}
+
+// requiredLiterals returns strings one of which every match of re contains,
+// or nil when there is no such set.
+func requiredLiterals(re *syntax.Regexp) []string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase != 0 {
+			return nil
+		}
+		return []string{string(re.Rune)}
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredLiterals(re.Sub[0])
+	case syntax.OpConcat:
+		var best []string
+		for _, sub := range re.Sub {
+			if literals := requiredLiterals(sub); literals != nil && (best == nil || shortestLen(literals) > shortestLen(best)) {
+				best = literals
+			}
+		}
+		return best
+	case syntax.OpAlternate:
+		var literals []string
+		for _, sub := range re.Sub {
+			subLiterals := requiredLiterals(sub)
+			if subLiterals == nil {
+				return nil
+			}
+			literals = append(literals, subLiterals...)
+		}
+		return literals
+	}
+
+	return nil
+}
+
+func shortestLen(values []string) int {
+	n := len(values[0])
+	for _, value := range values[1:] {
+		n = min(n, len(value))
+	}
+
+	return n
+}
+
+func containsAny(s string, values []string) bool {
+	for _, value := range values {
+		if strings.Contains(s, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ruleTrie holds exact and "*." rules under hosts, keyed by label from the
+// public suffix inwards, and public suffix wildcards like "www.google.*"
+// under suffixes, keyed from the registrable label outwards.
+type ruleTrie[T any] struct {
+	hosts    hostNode[T]
+	suffixes hostNode[T]
+}
+
+type hostNode[T any] struct {
+	children map[string]*hostNode[T]
+	exact    *pathNode[T]
+	wildcard *pathNode[T]
+}
+
+type pathNode[T any] struct {
+	children map[string]*pathNode[T]
+	key      string
+	rule     T
+	ok       bool
+}
+
+type cloner[T any] interface {
+	clone() T
+}
+
+func compileRuleTrie[T cloner[T]](rules map[string]T) *ruleTrie[T] {
+	t := &ruleTrie[T]{}
+	for key, rule := range rules {
+		// Unicode keys are stored under their IDNA ASCII form, the one urls
+		// are matched by, and give way to a rule keyed by that form itself.
+		ascii := asciiRuleKey(key)
+		host, rulePath := ascii, ""
+		if i := strings.Index(ascii, "/"); i != -1 {
+			host, rulePath = ascii[:i], ascii[i:]
+		}
+
+		var root **pathNode[T]
+		switch {
+		case strings.HasPrefix(host, "*."):
+			root = &t.hosts.insert(host[2:]).wildcard
+		case strings.HasSuffix(host, ".*"):
+			root = &t.suffixes.insert(strings.TrimSuffix(host, ".*")).exact
+		default:
+			root = &t.hosts.insert(host).exact
+		}
+		if *root == nil {
+			*root = &pathNode[T]{}
+		}
+
+		node := *root
+		for _, segment := range strings.Split(path.Clean("/"+rulePath), "/") {
+			if segment != "" {
+				node = node.insert(segment)
+			}
+		}
+		if node.ok && key != ascii {
+			continue
+		}
+		node.key, node.rule, node.ok = key, rule.clone(), true
+	}
+
+	return t
+}
+
+// find follows the same precedence as findRule: hosts, their subdomain
+// wildcards and registered domain, then public suffix wildcards, the longest
+// path prefix winning within each group and the most specific host breaking
+// ties.
+func (t *ruleTrie[T]) find(u *richUrl) (T, ruleKey, bool) {
+	p := u.Path
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	p = path.Clean(p)
+
+	var stack [8]*hostNode[T]
+	nodes := t.hosts.walk(u.Host, stack[:0])
+	labels := strings.Count(u.Host, ".") + 1
+	registered := strings.Count(u.RegisteredDomain(), ".") + 1
+
+	var m pathMatch[T]
+	if len(nodes) == labels {
+		m.consider(nodes[labels-1].exact, p, HostVariation)
+	}
+	for depth := min(labels-1, len(nodes)); depth >= registered; depth-- {
+		m.consider(nodes[depth-1].wildcard, p, SubdomainWildcardVariation)
+	}
+	if registered < labels && registered <= len(nodes) {
+		m.consider(nodes[registered-1].exact, p, RegisteredDomainVariation)
+	}
+	if m.node != nil {
+		return m.result()
+	}
+
+	if domain := t.suffixes.children[u.Domain]; domain != nil && u.ICANN {
+		if u.Subdomain != "" {
+			if sub := domain.walk(u.Subdomain, stack[:0]); len(sub) == strings.Count(u.Subdomain, ".")+1 {
+				m.consider(sub[len(sub)-1].exact, p, PublicSuffixWildcardVariation)
+			}
+		}
+		m.consider(domain.exact, p, PublicSuffixWildcardVariation)
+	}
+	if m.node != nil {
+		return m.result()
+	}
+
+	var none T
+	return none, ruleKey{}, false
+}
+
+func (n *hostNode[T]) insert(host string) *hostNode[T] {
+	for host != "" {
+		var label string
+		label, host = lastLabel(host)
+		child := n.children[label]
+		if child == nil {
+			if n.children == nil {
+				n.children = make(map[string]*hostNode[T])
+			}
+			child = &hostNode[T]{}
+			n.children[label] = child
+		}
+		n = child
+	}
+
+	return n
+}
+
+// walk appends the node for each label of host, from the last label inwards,
+// stopping at the first label without a node.
+func (n *hostNode[T]) walk(host string, nodes []*hostNode[T]) []*hostNode[T] {
+	for host != "" {
+		var label string
+		label, host = lastLabel(host)
+		if n = n.children[label]; n == nil {
+			break
+		}
+		nodes = append(nodes, n)
+	}
+
+	return nodes
+}
+
+func lastLabel(host string) (string, string) {
+	i := strings.LastIndexByte(host, '.')
+	if i == -1 {
+		return host, ""
+	}
+
+	return host[i+1:], host[:i]
+}
+
+func (n *pathNode[T]) insert(segment string) *pathNode[T] {
+	child := n.children[segment]
+	if child == nil {
+		if n.children == nil {
+			n.children = make(map[string]*pathNode[T])
+		}
+		child = &pathNode[T]{}
+		n.children[segment] = child
+	}
+
+	return child
+}
+
+// longest returns the rule node for the longest prefix of p, counted in path
+// segments.
+func (n *pathNode[T]) longest(p string) (*pathNode[T], int) {
+	var best *pathNode[T]
+	bestDepth := 0
+	if n.ok {
+		best = n
+	}
+
+	p = strings.TrimPrefix(p, "/")
+	for depth := 1; p != ""; depth++ {
+		segment := p
+		if i := strings.IndexByte(p, '/'); i != -1 {
+			segment, p = p[:i], p[i+1:]
+		} else {
+			p = ""
+		}
+
+		if n = n.children[segment]; n == nil {
+			break
+		}
+		if n.ok {
+			best, bestDepth = n, depth
+		}
+	}
+
+	return best, bestDepth
+}
+
+type pathMatch[T any] struct {
+	node      *pathNode[T]
+	depth     int
+	variation MatchVariation
+}
+
+// consider keeps the match from root if its path is longer than the current
+// one, so earlier candidates win ties.
+func (m *pathMatch[T]) consider(root *pathNode[T], p string, variation MatchVariation) {
+	if root == nil {
+		return
+	}
+
+	node, depth := root.longest(p)
+	if node == nil || (m.node != nil && depth <= m.depth) {
+		return
+	}
+	if depth > 0 {
+		variation = pathVariation(variation)
+	}
+	m.node, m.depth, m.variation = node, depth, variation
+}
+
+func (m *pathMatch[T]) result() (T, ruleKey, bool) {
+	return m.node.rule, ruleKey{m.node.key, m.variation}, true
+}
@@ -0,0 +1,206 @@
+package goreferrer
+
+import (
+	"path"
+	"regexp/syntax"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var benchmarkUrls = []string{
+	"http://ca.search.yahoo.com/search?p=hello",
+	"https://www.google.co.in/url?sa=t&rct=j&q=test+query&source=web&cd=1",
+	"https://twitter.com/jdoe/status/391149968360103936",
+	"http://blu172.mail.live.com/?rru=inbox",
+	"https://l.facebook.com/l.php?u=https%3A%2F%2Fshop.example.com%2F",
+	"https://www.bing.com/images/search?q=boots",
+	"http://yoursite.com/links",
+	"http://blapblap",
+}
+
+// classifierCorpus returns urls covering every default domain rule, with and
+// without extra path segments, plus the benchmark urls.
+func classifierCorpus() []string {
+	corpus := append([]string{}, benchmarkUrls...)
+	for key := range DefaultRules.DomainRules {
+		host := strings.Replace(key, "*.", "xyz.", 1)
+		for _, suffix := range []string{"com", "co.uk"} {
+			url := "http://" + strings.Replace(host, ".*", "."+suffix, 1)
+			corpus = append(corpus, url, url+"/a/b?q=hello", "www."+url)
+		}
+	}
+	for key := range DefaultRules.RedirectRules {
+		url := "http://" + strings.Replace(strings.Replace(key, "*.", "xyz.", 1), ".*", ".com", 1)
+		corpus = append(corpus, url+"?u=https://shop.example.com/&url=https://shop.example.com/")
+	}
+
+	return corpus
+}
+
+func TestClassifierMatchesRuleSet(t *testing.T) {
+	for _, url := range classifierCorpus() {
+		assert.Equal(t, DefaultRules.Parse(url), DefaultClassifier.Parse(url), url)
+	}
+
+	agent := "Mozilla/5.0 (iPhone; CPU iPhone OS 16_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [FBAN/MessengerForiOS;FBAV/388.0.0.26.109]"
+	assert.Equal(t, DefaultRules.ParseWith("", nil, agent), DefaultClassifier.ParseWith("", nil, agent))
+
+	landing := "https://shop.example.com/?gclid=abc123&utm_medium=cpc"
+	assert.Equal(t, DefaultRules.ParseWithLanding("", landing, nil, ""), DefaultClassifier.ParseWithLanding("", landing, nil, ""))
+}
+
+func TestClassifierLongestPathMatch(t *testing.T) {
+	rules := RuleSet{
+		DomainRules: map[string]DomainRule{
+			"zambo.com":            {Type: Search, Label: "Zambo"},
+			"zambo.com/images":     {Type: Search, Label: "Zambo Images"},
+			"www.zambo.com/images": {Type: Search, Label: "Zambo Www Images"},
+			"zambo.com/images/hd":  {Type: Search, Label: "Zambo HD Images"},
+			"*.zambo.com/news":     {Type: Search, Label: "Zambo News"},
+		},
+	}
+	classifier := rules.Compile()
+
+	cases := []struct {
+		url       string
+		label     string
+		variation MatchVariation
+	}{
+		{"http://zambo.com/", "Zambo", HostVariation},
+		{"http://m.zambo.com/images/cats", "Zambo Images", RegisteredDomainPathVariation},
+		{"http://www.zambo.com/images/cats", "Zambo Www Images", HostPathVariation},
+		{"http://www.zambo.com/images/hd/cats", "Zambo HD Images", RegisteredDomainPathVariation},
+		{"http://www.zambo.com/imagesx", "Zambo", RegisteredDomainVariation},
+		{"http://www.zambo.com/news", "Zambo News", SubdomainWildcardVariation},
+	}
+	for _, c := range cases {
+		actual := classifier.Parse(c.url)
+		assert.Equal(t, c.label, actual.Label, c.url)
+		assert.Equal(t, c.variation, actual.Match.Variation, c.url)
+		assert.Equal(t, rules.Parse(c.url), actual, c.url)
+	}
+}
+
+func TestClassifierUaRuleLiterals(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		literals []string
+	}{
+		{`Instagram \d`, []string{"Instagram "}},
+		{`musical_ly|BytedanceWebview|TikTok \d`, []string{"musical_ly", "BytedanceWebview", "TikTok "}},
+		{`\bLine/\d`, []string{"Line/"}},
+		{`(?i)line/\d`, nil},
+		{`Line|\d+`, nil},
+	}
+	for _, c := range cases {
+		re, err := syntax.Parse(c.pattern, syntax.Perl)
+		assert.NoError(t, err)
+		assert.Equal(t, c.literals, requiredLiterals(re), c.pattern)
+	}
+
+	agents := []string{
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 musical_ly_32.1.0 JsSdk/2.0",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 16_3 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Safari Line/13.1.0",
+		"Mozilla/5.0 (Linux; Android 13; Pixel 7 Build/TQ3A.230901.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/116.0.5845.163 Mobile Safari/537.36",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+	}
+	for _, agent := range agents {
+		assert.Equal(t, DefaultRules.ParseWith("", nil, agent), DefaultClassifier.ParseWith("", nil, agent), agent)
+	}
+}
+
+func TestClassifierIsNotAffectedByRuleSetChanges(t *testing.T) {
+	rules := NewRuleSet()
+	rules.DomainRules["zambo.com"] = DomainRule{Type: Search, Label: "Zambo"}
+	classifier := rules.Compile()
+
+	delete(rules.DomainRules, "zambo.com")
+	assert.Equal(t, "Zambo", classifier.Parse("http://zambo.com").Label)
+	assert.Equal(t, Indirect, rules.Parse("http://zambo.com").Type)
+}
+
+func BenchmarkRuleSetParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DefaultRules.Parse(benchmarkUrls[i%len(benchmarkUrls)])
+	}
+}
+
+func BenchmarkClassifierParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DefaultClassifier.Parse(benchmarkUrls[i%len(benchmarkUrls)])
+	}
+}
+
+func BenchmarkRuleSetParseWithAgent(b *testing.B) {
+	agent := "Mozilla/5.0 (iPhone; CPU iPhone OS 16_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Safari/604.1"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DefaultRules.ParseWith(benchmarkUrls[i%len(benchmarkUrls)], nil, agent)
+	}
+}
+
+func BenchmarkClassifierParseWithAgent(b *testing.B) {
+	agent := "Mozilla/5.0 (iPhone; CPU iPhone OS 16_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Safari/604.1"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DefaultClassifier.ParseWith(benchmarkUrls[i%len(benchmarkUrls)], nil, agent)
+	}
+}
+
+// lookupUrls parses the benchmark urls up front so lookup benchmarks measure
+// finding the domain rule alone.
+func lookupUrls() []*richUrl {
+	var urls []*richUrl
+	for _, url := range benchmarkUrls {
+		if u, ok := parseRichUrl(url); ok {
+			urls = append(urls, u)
+		}
+	}
+
+	return urls
+}
+
+// BenchmarkFourProbeLookup is the lookup the library used before wildcard
+// rules: host and registered domain, each with and without the path.
+func BenchmarkFourProbeLookup(b *testing.B) {
+	urls := lookupUrls()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u := *urls[i%len(urls)]
+		for _, host := range []string{
+			path.Join(u.Host, u.Path),
+			path.Join(u.RegisteredDomain(), u.Path),
+			u.Host,
+			u.RegisteredDomain(),
+		} {
+			if _, exists := DefaultRules.DomainRules[host]; exists {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkRuleSetLookup(b *testing.B) {
+	urls := lookupUrls()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u := *urls[i%len(urls)]
+		DefaultRules.getDomainRule(&u)
+	}
+}
+
+func BenchmarkClassifierLookup(b *testing.B) {
+	urls := lookupUrls()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u := *urls[i%len(urls)]
+		DefaultClassifier.getDomainRule(&u)
+	}
+}
@@ -0,0 +1,162 @@
+// Command referrer-rules converts Snowplow referer-parser databases into the
+// domain rules embedded in goreferrer.
+//
+//	referrer-rules [-defaults] [-local local_domain_rules.json] [-o default_domain_rules.go] referers.yml ...
+//
+// Inputs ending in .yml or .yaml are read as YAML, anything else as JSON, and
+// later inputs override earlier ones domain by domain. With -defaults the
+// currently embedded rules are the base the inputs override, so upstream
+// changes to a domain take effect. Local overrides are merged last; hosts
+// they already give the same label through a wildcard are dropped from the
+// inputs, so the wildcard's patterns apply to them.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Shopify/goreferrer"
+)
+
+func main() {
+	output := flag.String("o", "", "write the generated Go file here instead of stdout")
+	pkg := flag.String("package", "goreferrer", "package name of the generated file")
+	defaults := flag.Bool("defaults", false, "start from the currently embedded rules")
+	var local fileList
+	flag.Var(&local, "local", "merge these local overrides over the inputs, may be repeated")
+	flag.Parse()
+
+	if err := run(*output, *pkg, *defaults, local, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "referrer-rules:", err)
+		os.Exit(1)
+	}
+}
+
+type fileList []string
+
+func (l *fileList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *fileList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func run(output, pkg string, defaults bool, local, inputs []string) error {
+	if len(inputs) == 0 && len(local) == 0 && !defaults {
+		return fmt.Errorf("no input files")
+	}
+
+	rules := make(map[string]goreferrer.DomainRule)
+	if defaults {
+		for domain, rule := range goreferrer.DefaultRules.DomainRules {
+			rules[domain] = rule
+		}
+	}
+	if err := merge(rules, inputs); err != nil {
+		return err
+	}
+
+	overrides := make(map[string]goreferrer.DomainRule)
+	if err := merge(overrides, local); err != nil {
+		return err
+	}
+	dropCovered(rules, overrides)
+	for domain, rule := range overrides {
+		rules[domain] = rule
+	}
+
+	for _, diagnostic := range goreferrer.ValidateDomainRules(rules) {
+		if diagnostic.Kind == goreferrer.InvalidDomain {
+			fmt.Fprintln(os.Stderr, "referrer-rules: skipping", diagnostic)
+			delete(rules, diagnostic.Rule.Domain)
+			continue
+		}
+		fmt.Fprintln(os.Stderr, "referrer-rules: warning:", diagnostic)
+	}
+
+	var encoded bytes.Buffer
+	if err := goreferrer.WriteJsonDomainRules(&encoded, rules); err != nil {
+		return err
+	}
+	if bytes.ContainsRune(encoded.Bytes(), '`') {
+		return fmt.Errorf("rules contain a backquote")
+	}
+	if _, err := goreferrer.LoadJsonDomainRules(bytes.NewReader(encoded.Bytes())); err != nil {
+		return fmt.Errorf("generated rules do not load: %v", err)
+	}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// Code generated by referrer-rules. DO NOT EDIT.\n\npackage %s\n\nconst defaultRules = `\n%s`\n", pkg, encoded.Bytes())
+
+	if output == "" {
+		_, err := os.Stdout.Write(source.Bytes())
+		return err
+	}
+
+	return os.WriteFile(output, source.Bytes(), 0644)
+}
+
+func merge(rules map[string]goreferrer.DomainRule, inputs []string) error {
+	for _, input := range inputs {
+		loaded, err := load(input)
+		if err != nil {
+			return fmt.Errorf("%s: %v", input, err)
+		}
+		for domain, rule := range loaded {
+			rules[domain] = rule
+		}
+	}
+
+	return nil
+}
+
+// dropCovered removes the hosts that a wildcard of the overrides matches
+// with the same label, such as upstream's per-country Google hosts under a
+// local "google.*" carrying paid paths.
+func dropCovered(rules, overrides map[string]goreferrer.DomainRule) {
+	matcher := goreferrer.RuleSet{DomainRules: overrides}
+	for domain, rule := range rules {
+		if _, listed := overrides[domain]; listed || strings.Contains(domain, "*") {
+			continue
+		}
+
+		ref := matcher.Parse("http://" + domain)
+		wildcard := ref.Match.Variation == goreferrer.SubdomainWildcardVariation || ref.Match.Variation == goreferrer.PublicSuffixWildcardVariation
+		if wildcard && ref.Type == rule.Type && ref.Label == rule.Label {
+			delete(rules, domain)
+		}
+	}
+}
+
+// load reads an input, reporting domains listed under several labels and
+// the label they resolve to.
+func load(input string) (map[string]goreferrer.DomainRule, error) {
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return nil, err
+	}
+
+	validate, decode := goreferrer.ValidateJsonDomainRules, goreferrer.LoadJsonDomainRules
+	switch strings.ToLower(filepath.Ext(input)) {
+	case ".yml", ".yaml":
+		validate, decode = goreferrer.ValidateYamlDomainRules, goreferrer.LoadYamlDomainRules
+	}
+
+	diagnostics, err := validate(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Kind == goreferrer.DuplicateDomain {
+			fmt.Fprintf(os.Stderr, "referrer-rules: %s: %s\n", input, diagnostic)
+		}
+	}
+
+	return decode(bytes.NewReader(data))
+}
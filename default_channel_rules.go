@@ -0,0 +1,195 @@
+package goreferrer
+
+import (
+	"strings"
+)
+
+var DefaultChannelRules ChannelRules
+
+func init() {
+	channelRules, err := LoadJsonChannelRules(strings.NewReader(defaultChannelRules))
+	if err != nil {
+		panic(err)
+	}
+
+	DefaultChannelRules = channelRules
+}
+
+const defaultChannelRules = `
+{
+    "sources": {
+        "search": [
+            "alice",
+            "aol",
+            "ask",
+            "avg",
+            "baidu",
+            "biglobe",
+            "bing",
+            "brave",
+            "cn.bing.com",
+            "daum",
+            "dogpile",
+            "duckduckgo",
+            "ecosia",
+            "goo",
+            "google",
+            "incredimail",
+            "lens.google.com",
+            "lycos",
+            "m.baidu.com",
+            "m.naver.com",
+            "m.search.naver.com",
+            "msn",
+            "najdi",
+            "naver",
+            "onet",
+            "qwant",
+            "rakuten",
+            "search-results",
+            "search.aol.com",
+            "search.brave.com",
+            "search.yahoo.com",
+            "seznam",
+            "so.com",
+            "sogou",
+            "startpage",
+            "startsiden",
+            "tut.by",
+            "ukr",
+            "virgilio",
+            "yahoo",
+            "yahoo.co.jp",
+            "yandex"
+        ],
+        "social": [
+            "blogger",
+            "discord",
+            "discord.com",
+            "facebook",
+            "facebook.com",
+            "fb",
+            "ig",
+            "instagram",
+            "instagram.com",
+            "l.facebook.com",
+            "l.instagram.com",
+            "line",
+            "linkedin",
+            "linkedin.com",
+            "lm.facebook.com",
+            "lnkd.in",
+            "m.facebook.com",
+            "medium.com",
+            "meetup",
+            "messenger",
+            "ok.ru",
+            "out.reddit.com",
+            "pinterest",
+            "pinterest.com",
+            "quora",
+            "quora.com",
+            "reddit",
+            "reddit.com",
+            "snapchat",
+            "snapchat.com",
+            "t.co",
+            "threads.net",
+            "tiktok",
+            "tiktok.com",
+            "tumblr",
+            "tumblr.com",
+            "twitter",
+            "twitter.com",
+            "vk.com",
+            "wechat",
+            "weibo",
+            "whatsapp",
+            "whatsapp.com",
+            "x.com",
+            "yelp"
+        ],
+        "shopping": [
+            "alibaba",
+            "aliexpress",
+            "amazon",
+            "amazon.com",
+            "apps.shopify.com",
+            "checkout.shopify.com",
+            "ebay",
+            "ebay.com",
+            "etsy",
+            "etsy.com",
+            "google shopping",
+            "igshopping",
+            "mercadolibre",
+            "shop.app",
+            "shopify",
+            "shopify.com",
+            "shopping.google.com",
+            "shopzilla",
+            "stripe",
+            "target.com",
+            "walmart",
+            "walmart.com"
+        ],
+        "video": [
+            "dailymotion",
+            "dailymotion.com",
+            "disneyplus",
+            "hulu",
+            "iqiyi",
+            "m.youtube.com",
+            "netflix",
+            "player.vimeo.com",
+            "primevideo",
+            "ted",
+            "twitch",
+            "twitch.tv",
+            "vimeo",
+            "vimeo.com",
+            "wistia",
+            "youku",
+            "youtube",
+            "youtube.com"
+        ]
+    },
+    "mediums": {
+        "display": [
+            "display",
+            "banner",
+            "expandable",
+            "interstitial",
+            "cpm"
+        ],
+        "social": [
+            "social",
+            "social-network",
+            "social-media",
+            "sm",
+            "social network",
+            "social media"
+        ],
+        "referral": [
+            "referral",
+            "app",
+            "link"
+        ],
+        "email": [
+            "email",
+            "e-mail",
+            "e_mail",
+            "e mail"
+        ],
+        "affiliate": [
+            "affiliate"
+        ],
+        "audio": [
+            "audio"
+        ],
+        "sms": [
+            "sms"
+        ]
+    }
+}
+`
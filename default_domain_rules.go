@@ -0,0 +1,2963 @@
+// Code generated by referrer-rules. DO NOT EDIT.
+
+package goreferrer
+
+const defaultRules = `
+{
+    "ai": {
+        "ChatGPT": {
+            "domains": [
+                "chat.openai.com",
+                "chatgpt.com"
+            ]
+        },
+        "Claude": {
+            "domains": [
+                "claude.ai"
+            ]
+        },
+        "Copilot": {
+            "domains": [
+                "copilot.microsoft.com"
+            ]
+        },
+        "Gemini": {
+            "domains": [
+                "bard.google.com",
+                "gemini.google.com"
+            ]
+        },
+        "Perplexity": {
+            "domains": [
+                "perplexity.ai"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Phind": {
+            "domains": [
+                "phind.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "You.com": {
+            "domains": [
+                "you.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        }
+    },
+    "email": {
+        "126 Mail": {
+            "domains": [
+                "mail.126.com"
+            ]
+        },
+        "163 Mail": {
+            "domains": [
+                "mail.163.com"
+            ]
+        },
+        "2degrees": {
+            "domains": [
+                "webmail.2degreesbroadband.co.nz"
+            ]
+        },
+        "AOL Mail": {
+            "domains": [
+                "cpw.mail.aol.com",
+                "mail.aol.com"
+            ]
+        },
+        "Adam Internet": {
+            "domains": [
+                "webmail.adam.com.au"
+            ]
+        },
+        "Bigpond": {
+            "domains": [
+                "basic.messaging.bigpond.com",
+                "email.telstra.com",
+                "webmail.bigpond.com",
+                "webmail2.bigpond.com"
+            ]
+        },
+        "Commander": {
+            "domains": [
+                "webmail.commander.net.au"
+            ]
+        },
+        "Daum Mail": {
+            "domains": [
+                "mail.daum.net",
+                "mail2.daum.net"
+            ]
+        },
+        "Dodo": {
+            "domains": [
+                "webmail.dodo.com.au"
+            ]
+        },
+        "Freenet": {
+            "domains": [
+                "webmail.freenet.de"
+            ]
+        },
+        "Gmail": {
+            "domains": [
+                "inbox.google.com",
+                "mail.google.com"
+            ]
+        },
+        "MailChimp": {
+            "domains": [
+                "list-manage.com",
+                "list-manage1.com",
+                "list-manage2.com",
+                "list-manage3.com",
+                "list-manage4.com",
+                "list-manage5.com",
+                "list-manage6.com",
+                "list-manage7.com",
+                "list-manage8.com",
+                "list-manage9.com"
+            ]
+        },
+        "Mimecast": {
+            "domains": [
+                "mimecast.com"
+            ]
+        },
+        "Mynet Mail": {
+            "domains": [
+                "mail.mynet.com"
+            ]
+        },
+        "Naver Mail": {
+            "domains": [
+                "mail.naver.com"
+            ]
+        },
+        "Netspace": {
+            "domains": [
+                "webmail.netspace.net.au"
+            ]
+        },
+        "Optus Zoo": {
+            "domains": [
+                "webmail.optusnet.com.au",
+                "webmail.optuszoo.com.au"
+            ]
+        },
+        "Orange Webmail": {
+            "domains": [
+                "orange.fr/webmail"
+            ]
+        },
+        "Outlook.com": {
+            "domains": [
+                "*.mail.live.com",
+                "mail.live.com",
+                "outlook.com",
+                "outlook.live.com",
+                "outlook.office.com"
+            ]
+        },
+        "Proofpoint": {
+            "domains": [
+                "urldefense.com",
+                "urldefense.proofpoint.com"
+            ]
+        },
+        "QQ Mail": {
+            "domains": [
+                "mail.qq.com"
+            ]
+        },
+        "Seznam Mail": {
+            "domains": [
+                "email.seznam.cz"
+            ]
+        },
+        "Virgin": {
+            "domains": [
+                "webmail.virginbroadband.com.au"
+            ]
+        },
+        "Vodafone": {
+            "domains": [
+                "webmail.vodafone.co.nz"
+            ]
+        },
+        "Westnet": {
+            "domains": [
+                "webmail.westnet.com.au"
+            ]
+        },
+        "Xfinity": {
+            "domains": [
+                "web.mail.comcast.net"
+            ]
+        },
+        "Yahoo! Mail": {
+            "domains": [
+                "mail.yahoo.co.jp",
+                "mail.yahoo.co.uk",
+                "mail.yahoo.com",
+                "mail.yahoo.net"
+            ]
+        },
+        "Zoho": {
+            "domains": [
+                "mail.zoho.com"
+            ]
+        },
+        "iPrimus": {
+            "domains": [
+                "webmail.iprimus.com.au"
+            ]
+        },
+        "iiNet": {
+            "domains": [
+                "mail.iinet.net.au",
+                "webmail.iinet.net.au"
+            ]
+        }
+    },
+    "search": {
+        "1\u00261": {
+            "domains": [
+                "search.1and1.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "1.cz": {
+            "domains": [
+                "1.cz"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "1und1": {
+            "domains": [
+                "search.1und1.de"
+            ],
+            "parameters": [
+                "su"
+            ]
+        },
+        "360.cn": {
+            "domains": [
+                "so.360.cn",
+                "www.so.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "ABCsøk": {
+            "domains": [
+                "abcsolk.no",
+                "verden.abcsok.no"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "AOL": {
+            "domains": [
+                "aim.search.aol.com",
+                "alicesuche.aol.de",
+                "alicesuchet.aol.de",
+                "aolbusqueda.aol.com.mx",
+                "aolrecherche.aol.fr",
+                "aolsearch.aol.co.uk",
+                "aolsearch.aol.com",
+                "aolsearch.com",
+                "find.web.aol.com",
+                "m.search.aol.com",
+                "recherche.aol.ca",
+                "recherche.aol.fr",
+                "search-intl.netscape.com",
+                "search.aol.ca",
+                "search.aol.co.uk",
+                "search.aol.com",
+                "search.aol.it",
+                "search.hp.my.aol.com.au",
+                "search.hp.my.aol.de",
+                "search.hp.my.aol.it",
+                "suche.aol.de",
+                "suche.aolsvc.de",
+                "sucheaol.aol.de",
+                "suchet2.aol.de",
+                "www.aol.com",
+                "www.aolimages.aol.fr",
+                "www.aolrecherche.aol.fr",
+                "www.aolrecherches.aol.fr",
+                "www.recherche.aol.fr"
+            ],
+            "parameters": [
+                "q",
+                "query"
+            ]
+        },
+        "APOLL07": {
+            "domains": [
+                "apollo7.de"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Abacho": {
+            "domains": [
+                "www.abacho.at",
+                "www.abacho.ch",
+                "www.abacho.co.uk",
+                "www.abacho.com",
+                "www.abacho.de",
+                "www.abacho.es",
+                "www.abacho.fr",
+                "www.abacho.it",
+                "www.se.abacho.com",
+                "www.tr.abacho.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Acoon": {
+            "domains": [
+                "www.acoon.de"
+            ],
+            "parameters": [
+                "begriff"
+            ]
+        },
+        "Alexa": {
+            "domains": [
+                "alexa.com",
+                "search.toolbars.alexa.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Alice Adsl": {
+            "domains": [
+                "rechercher.aliceadsl.fr"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "AllTheWeb": {
+            "domains": [
+                "www.alltheweb.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Altavista": {
+            "domains": [
+                "altavista.de",
+                "altavista.fr",
+                "be-fr.altavista.com",
+                "be-nl.altavista.com",
+                "listings.altavista.com",
+                "search.altavista.com",
+                "www.altavista.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Amazon": {
+            "domains": [
+                "amazon.ca",
+                "amazon.co.jp",
+                "amazon.co.uk",
+                "amazon.com",
+                "amazon.de",
+                "amazon.es",
+                "amazon.fr",
+                "amazon.in",
+                "amazon.it",
+                "amazonaws.com",
+                "www.amazon.com"
+            ],
+            "parameters": [
+                "keywords",
+                "field-keywords"
+            ]
+        },
+        "Apollo Latvia": {
+            "domains": [
+                "apollo.lv/portal/search"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Apontador": {
+            "domains": [
+                "apontador.com.br",
+                "www.apontador.com.br"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Aport": {
+            "domains": [
+                "sm.aport.ru"
+            ],
+            "parameters": [
+                "r"
+            ]
+        },
+        "Arcor": {
+            "domains": [
+                "www.arcor.de"
+            ],
+            "parameters": [
+                "Keywords"
+            ]
+        },
+        "Arianna": {
+            "domains": [
+                "arianna.libero.it",
+                "www.arianna.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Ask": {
+            "domains": [
+                "ask.com",
+                "ask.reference.com",
+                "images.ask.com",
+                "int.ask.com",
+                "int.search-results.com",
+                "iwon.ask.com",
+                "mws.ask.com",
+                "search-results.com",
+                "uk.ask.com",
+                "uk.search-results.com",
+                "web.ask.com",
+                "www.ask.co.uk",
+                "www.ask.com",
+                "www.askkids.com",
+                "www.qbyrd.com",
+                "www.search-results.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Ask Toolbar": {
+            "domains": [
+                "search.tb.ask.com"
+            ],
+            "parameters": [
+                "searchfor"
+            ]
+        },
+        "Atlas": {
+            "domains": [
+                "searchatlas.centrum.cz"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Austronaut": {
+            "domains": [
+                "www1.astronaut.at",
+                "www2.austronaut.at"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Babylon": {
+            "domains": [
+                "search.babylon.com",
+                "searchassist.babylon.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Baidu": {
+            "domains": [
+                "fanyi.baidu.com",
+                "image.baidu.com",
+                "m.baidu.com",
+                "m.siteapp.baidu.com",
+                "m5.baidu.com",
+                "news.baidu.com",
+                "tieba.baidu.com",
+                "web.gougou.com",
+                "www.baidu.co.th",
+                "www.baidu.com",
+                "www1.baidu.com",
+                "zhidao.baidu.com"
+            ],
+            "parameters": [
+                "wd",
+                "word",
+                "kw",
+                "k"
+            ]
+        },
+        "Biglobe": {
+            "domains": [
+                "cgi.search.biglobe.ne.jp"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Bing": {
+            "domains": [
+                "bing.com",
+                "cc.bingj.com",
+                "dizionario.it.msn.com",
+                "m.bing.com",
+                "msnbc.msn.com",
+                "www.bing.com"
+            ],
+            "parameters": [
+                "q",
+                "Q"
+            ],
+            "paid_paths": [
+                "/aclk",
+                "/aclick"
+            ]
+        },
+        "Bing Images": {
+            "domains": [
+                "bing.com/images/search",
+                "www.bing.com/images/search"
+            ],
+            "parameters": [
+                "q",
+                "Q"
+            ]
+        },
+        "Blogdigger": {
+            "domains": [
+                "www.blogdigger.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Blogpulse": {
+            "domains": [
+                "www.blogpulse.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Bluewin": {
+            "domains": [
+                "search.bluewin.ch"
+            ],
+            "parameters": [
+                "searchTerm"
+            ]
+        },
+        "British Telecommunications": {
+            "domains": [
+                "search.bt.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Centrum": {
+            "domains": [
+                "morfeo.centrum.cz",
+                "serach.centrum.cz"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Certified-Toolbar": {
+            "domains": [
+                "search.certified-toolbar.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Charter": {
+            "domains": [
+                "www.charter.net"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Clix": {
+            "domains": [
+                "pesquisa.clix.pt"
+            ],
+            "parameters": [
+                "question"
+            ]
+        },
+        "Comcast": {
+            "domains": [
+                "comcast.net",
+                "search.comcast.net",
+                "xfinity.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Compuserve": {
+            "domains": [
+                "websearch.cs.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Conduit": {
+            "domains": [
+                "search.conduit.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Crawler": {
+            "domains": [
+                "www.crawler.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Cuil": {
+            "domains": [
+                "www.cuil.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Daemon search": {
+            "domains": [
+                "daemon-search.com",
+                "my.daemon-search.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Dalesearch": {
+            "domains": [
+                "www.dalesearch.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "DasOertliche": {
+            "domains": [
+                "www.dasoertliche.de"
+            ],
+            "parameters": [
+                "kw"
+            ]
+        },
+        "DasTelefonbuch": {
+            "domains": [
+                "www1.dastelefonbuch.de"
+            ],
+            "parameters": [
+                "kw"
+            ]
+        },
+        "Daum": {
+            "domains": [
+                "search.daum.net"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Delfi": {
+            "domains": [
+                "otsing.delfi.ee"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Delfi latvia": {
+            "domains": [
+                "smart.delfi.lv"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Digg": {
+            "domains": [
+                "digg.com"
+            ],
+            "parameters": [
+                "s"
+            ]
+        },
+        "Dodo": {
+            "domains": [
+                "google.dodo.com.au"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "DuckDuckGo": {
+            "domains": [
+                "duckduckgo.com"
+            ],
+            "parameters": [
+                "q"
+            ],
+            "paid_paths": [
+                "/y.js"
+            ]
+        },
+        "Ecosia": {
+            "domains": [
+                "ecosia.org"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "El Mundo": {
+            "domains": [
+                "ariadna.elmundo.es"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Eniro": {
+            "domains": [
+                "www.eniro.se"
+            ],
+            "parameters": [
+                "q",
+                "search_word"
+            ]
+        },
+        "Eurip": {
+            "domains": [
+                "www.eurip.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Euroseek": {
+            "domains": [
+                "www.euroseek.com"
+            ],
+            "parameters": [
+                "string"
+            ]
+        },
+        "Everyclick": {
+            "domains": [
+                "www.everyclick.com"
+            ],
+            "parameters": [
+                "keyword"
+            ]
+        },
+        "Exalead": {
+            "domains": [
+                "www.exalead.com",
+                "www.exalead.fr"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Excite": {
+            "domains": [
+                "msxml.excite.com",
+                "search.excite.co.uk",
+                "search.excite.de",
+                "search.excite.fr",
+                "search.excite.it",
+                "search.excite.nl",
+                "serach.excite.es",
+                "www.excite.co.jp"
+            ],
+            "parameters": [
+                "q",
+                "search"
+            ]
+        },
+        "Fast Browser Search": {
+            "domains": [
+                "www.fastbrowsersearch.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Finderoo": {
+            "domains": [
+                "www.finderoo.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Findwide": {
+            "domains": [
+                "search.findwide.com"
+            ],
+            "parameters": [
+                "k"
+            ]
+        },
+        "Fireball": {
+            "domains": [
+                "www.fireball.de"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Firstfind": {
+            "domains": [
+                "www.firstsfind.com"
+            ],
+            "parameters": [
+                "qry"
+            ]
+        },
+        "Fixsuche": {
+            "domains": [
+                "www.fixsuche.de"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Flix": {
+            "domains": [
+                "www.flix.de"
+            ],
+            "parameters": [
+                "keyword"
+            ]
+        },
+        "Flyingbird": {
+            "domains": [
+                "inspsearch.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Forestle": {
+            "domains": [
+                "forestle.mobi",
+                "forestle.org",
+                "www.forestle.org"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Francite": {
+            "domains": [
+                "recherche.francite.com"
+            ],
+            "parameters": [
+                "name"
+            ]
+        },
+        "Free": {
+            "domains": [
+                "search.free.fr",
+                "search1-1.free.fr",
+                "search1-2.free.fr"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Freecause": {
+            "domains": [
+                "search.freecause.com"
+            ],
+            "parameters": [
+                "p"
+            ]
+        },
+        "Freenet": {
+            "domains": [
+                "suche.freenet.de"
+            ],
+            "parameters": [
+                "query",
+                "Keywords"
+            ]
+        },
+        "Freshweather": {
+            "domains": [
+                "www.fresh-weather.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "FriendFeed": {
+            "domains": [
+                "friendfeed.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "GAIS": {
+            "domains": [
+                "gais.cs.ccu.edu.tw"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "GMX": {
+            "domains": [
+                "suche.gmx.net"
+            ],
+            "parameters": [
+                "su"
+            ]
+        },
+        "Genieo": {
+            "domains": [
+                "search.genieo.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Geona": {
+            "domains": [
+                "geona.net"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Gigablast": {
+            "domains": [
+                "dir.gigablast.com",
+                "www.gigablast.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Globososo": {
+            "domains": [
+                "search.globososo.com",
+                "searches.globososo.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Gnadenmeer": {
+            "domains": [
+                "www.gnadenmeer.de"
+            ],
+            "parameters": [
+                "keyword"
+            ]
+        },
+        "Gomeo": {
+            "domains": [
+                "www.gomeo.com"
+            ],
+            "parameters": [
+                "Keywords"
+            ]
+        },
+        "Google": {
+            "domains": [
+                "darkoogle.com",
+                "encrypted.google.com",
+                "find.tdc.dk",
+                "google.*",
+                "googlesyndicatedsearch.com",
+                "isearch.avg.com",
+                "search.alot.com",
+                "search.avg.com",
+                "search.darkoogle.com",
+                "search.foxtab.com",
+                "search.hiyo.com",
+                "search.incredibar.com",
+                "search.incredimail.com",
+                "search.juno.com",
+                "search.sweetim.com",
+                "search.walla.co.il",
+                "search1.incredimail.com",
+                "search2.incredimail.com",
+                "search3.incredimail.com",
+                "search4.incredimail.com",
+                "searchresults.verizon.com",
+                "webcache.googleusercontent.com",
+                "www.cnn.com",
+                "www.fastweb.it",
+                "www.google.*",
+                "www.googleadservices.com",
+                "www.googleearth.de",
+                "www.googleearth.fr",
+                "www.gooofullsearch.com"
+            ],
+            "parameters": [
+                "q",
+                "query",
+                "Keywords",
+                "*"
+            ],
+            "paid_paths": [
+                "/aclk",
+                "/pagead/aclk"
+            ]
+        },
+        "Google Blogsearch": {
+            "domains": [
+                "blogsearch.google.*"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Google Images": {
+            "domains": [
+                "google.*/imgres",
+                "images.google.*"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Google News": {
+            "domains": [
+                "news.google.*"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Google Product Search": {
+            "domains": [
+                "google.*/products",
+                "www.google.*/products"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Google Video": {
+            "domains": [
+                "video.google.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Goyellow.de": {
+            "domains": [
+                "www.goyellow.de"
+            ],
+            "parameters": [
+                "MDN"
+            ]
+        },
+        "Gule Sider": {
+            "domains": [
+                "www.gulesider.no"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Haosou": {
+            "domains": [
+                "www.haosou.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "HighBeam": {
+            "domains": [
+                "www.highbeam.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Hit-Parade": {
+            "domains": [
+                "class.hit-parade.com",
+                "req.-hit-parade.com",
+                "www.hit-parade.com"
+            ],
+            "parameters": [
+                "p7"
+            ]
+        },
+        "Holmes": {
+            "domains": [
+                "holmes.ge"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Hooseek.com": {
+            "domains": [
+                "www.hooseek.com"
+            ],
+            "parameters": [
+                "recherche"
+            ]
+        },
+        "Hotbot": {
+            "domains": [
+                "www.hotbot.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "I-play": {
+            "domains": [
+                "start.iplay.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "I.ua": {
+            "domains": [
+                "search.i.ua"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "ICQ": {
+            "domains": [
+                "search.icq.com",
+                "www.icq.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "IXquick": {
+            "domains": [
+                "eu.ixquick.com",
+                "ixquick.com",
+                "ixquick.de",
+                "s1-eu.ixquick.de",
+                "s1.us.ixquick.com",
+                "s2.us.ixquick.com",
+                "s3.us.ixquick.com",
+                "s4.us.ixquick.com",
+                "s5.us.ixquick.com",
+                "s8-eu.ixquick.com",
+                "us.ixquick.com",
+                "www.eu.ixquick.com",
+                "www.ixquick.de"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Icerockeet": {
+            "domains": [
+                "blogs.icerocket.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Ilse": {
+            "domains": [
+                "www.ilse.nl"
+            ],
+            "parameters": [
+                "search_for"
+            ]
+        },
+        "Inbox": {
+            "domains": [
+                "inbox.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Inbox.com": {
+            "domains": [
+                "inbox.com/search"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Info": {
+            "domains": [
+                "info.com"
+            ],
+            "parameters": [
+                "qkw"
+            ]
+        },
+        "InfoSpace": {
+            "domains": [
+                "clusty.com",
+                "dogpile.com",
+                "infospace.com",
+                "isearch.babylon.com",
+                "metacrawler.com",
+                "search.kiwee.com",
+                "search.magnetic.com",
+                "search.searchcompletion.com",
+                "start.facemoods.com",
+                "webcrawler.com",
+                "webfetch.com",
+                "www.dogpile.com"
+            ],
+            "parameters": [
+                "q",
+                "s"
+            ]
+        },
+        "Interia": {
+            "domains": [
+                "www.google.interia.pl"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Jungle Key": {
+            "domains": [
+                "junglekey.com",
+                "junglekey.fr"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Jungle Spider": {
+            "domains": [
+                "www.jungle-spider.de"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Jyxo": {
+            "domains": [
+                "jyxo.1188.cz"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Kataweb": {
+            "domains": [
+                "www.kataweb.it"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Kvasir": {
+            "domains": [
+                "www.kvasir.no"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "La Toile Du Quebec Via Google": {
+            "domains": [
+                "web.toile.com",
+                "www.toile.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Latne": {
+            "domains": [
+                "www.latne.lv"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Lo.st": {
+            "domains": [
+                "lo.st"
+            ],
+            "parameters": [
+                "x_query"
+            ]
+        },
+        "Looksmart": {
+            "domains": [
+                "www.looksmart.com"
+            ],
+            "parameters": [
+                "key"
+            ]
+        },
+        "Lycos": {
+            "domains": [
+                "lycos.com",
+                "search.lycos.com",
+                "www.lycos.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Mail.ru": {
+            "domains": [
+                "go.mail.ru"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Mamma": {
+            "domains": [
+                "mamma75.mamma.com",
+                "www.mamma.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Marktplaats": {
+            "domains": [
+                "www.marktplaats.nl"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Maxwebsearch": {
+            "domains": [
+                "maxwebsearch.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Meinestadt": {
+            "domains": [
+                "www.meinestadt.de"
+            ],
+            "parameters": [
+                "words"
+            ]
+        },
+        "Meta": {
+            "domains": [
+                "meta.ua"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "MetaCrawler.de": {
+            "domains": [
+                "s1.metacrawler.de",
+                "s2.metacrawler.de",
+                "s3.metacrawler.de"
+            ],
+            "parameters": [
+                "qry"
+            ]
+        },
+        "Metager": {
+            "domains": [
+                "meta.rrzn.uni-hannover.de",
+                "www.metager.de"
+            ],
+            "parameters": [
+                "eingabe"
+            ]
+        },
+        "Metager2": {
+            "domains": [
+                "metager2.de"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Mister Wong": {
+            "domains": [
+                "www.mister-wong.com",
+                "www.mister-wong.de"
+            ],
+            "parameters": [
+                "Keywords"
+            ]
+        },
+        "Monstercrawler": {
+            "domains": [
+                "www.monstercrawler.com"
+            ],
+            "parameters": [
+                "qry"
+            ]
+        },
+        "Mozbot": {
+            "domains": [
+                "www.mozbot.co.uk",
+                "www.mozbot.com",
+                "www.mozbot.fr"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "MySearch": {
+            "domains": [
+                "kf.mysearch.myway.com",
+                "ki.mysearch.myway.com",
+                "ms114.mysearch.com",
+                "ms146.mysearch.com",
+                "search.myway.com",
+                "search.mywebsearch.com",
+                "www.mysearch.com"
+            ],
+            "parameters": [
+                "searchfor",
+                "searchFor"
+            ]
+        },
+        "Najdi": {
+            "domains": [
+                "www.najdi.si"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Nate": {
+            "domains": [
+                "search.nate.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Naver": {
+            "domains": [
+                "search.naver.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Naver Images": {
+            "domains": [
+                "image.search.naver.com",
+                "imagesearch.naver.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Needtofind": {
+            "domains": [
+                "ko.search.need2find.com"
+            ],
+            "parameters": [
+                "searchfor"
+            ]
+        },
+        "Neti": {
+            "domains": [
+                "www.neti.ee"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Nifty": {
+            "domains": [
+                "search.nifty.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Nigma": {
+            "domains": [
+                "nigma.ru"
+            ],
+            "parameters": [
+                "s"
+            ]
+        },
+        "Onet": {
+            "domains": [
+                "szukaj.onet.pl"
+            ],
+            "parameters": [
+                "qt"
+            ]
+        },
+        "Online.no": {
+            "domains": [
+                "online.no"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Opplysningen 1881": {
+            "domains": [
+                "www.1881.no"
+            ],
+            "parameters": [
+                "Query"
+            ]
+        },
+        "Orange": {
+            "domains": [
+                "busca.orange.es",
+                "search.orange.co.uk"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Paperball": {
+            "domains": [
+                "www.paperball.de"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "PeoplePC": {
+            "domains": [
+                "search.peoplepc.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Picsearch": {
+            "domains": [
+                "www.picsearch.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Plazoo": {
+            "domains": [
+                "www.plazoo.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "PriceRunner": {
+            "domains": [
+                "www.pricerunner.co.uk"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Qualigo": {
+            "domains": [
+                "www.qualigo.at",
+                "www.qualigo.ch",
+                "www.qualigo.de",
+                "www.qualigo.nl"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "RPMFind": {
+            "domains": [
+                "fr2.rpmfind.net",
+                "rpmfind.net"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Rakuten": {
+            "domains": [
+                "websearch.rakuten.co.jp"
+            ],
+            "parameters": [
+                "qt"
+            ]
+        },
+        "Rambler": {
+            "domains": [
+                "nova.rambler.ru"
+            ],
+            "parameters": [
+                "query",
+                "words"
+            ]
+        },
+        "Road Runner Search": {
+            "domains": [
+                "search.rr.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Sapo": {
+            "domains": [
+                "pesquisa.sapo.pt"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Search This": {
+            "domains": [
+                "www.searchthis.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Search.ch": {
+            "domains": [
+                "www.search.ch"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Search.com": {
+            "domains": [
+                "www.search.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "SearchCanvas": {
+            "domains": [
+                "www.searchcanvas.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "SearchLock": {
+            "domains": [
+                "searchlock.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Searchalot": {
+            "domains": [
+                "searchalot.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Searchy": {
+            "domains": [
+                "www.searchy.co.uk"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Seznam": {
+            "domains": [
+                "search.seznam.cz"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Sharelook": {
+            "domains": [
+                "www.sharelook.fr"
+            ],
+            "parameters": [
+                "keyword"
+            ]
+        },
+        "Skynet": {
+            "domains": [
+                "www.skynet.be"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Snapdo": {
+            "domains": [
+                "search.snapdo.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "SoSoDesk": {
+            "domains": [
+                "search.sosodesktop.com",
+                "sosodesktop.com",
+                "www.soso.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Softonic": {
+            "domains": [
+                "search.softonic.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Sogou": {
+            "domains": [
+                "www.sogou.com"
+            ],
+            "parameters": [
+                "query",
+                "w"
+            ]
+        },
+        "Startpagina": {
+            "domains": [
+                "startgoogle.startpagina.nl"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Startsiden": {
+            "domains": [
+                "www.startsiden.no"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Suchmaschine.com": {
+            "domains": [
+                "www.suchmaschine.com"
+            ],
+            "parameters": [
+                "suchstr"
+            ]
+        },
+        "Suchnase": {
+            "domains": [
+                "www.suchnase.de"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Superpages": {
+            "domains": [
+                "superpages.com"
+            ],
+            "parameters": [
+                "C"
+            ]
+        },
+        "T-Online": {
+            "domains": [
+                "brisbane.t-online.de",
+                "navigationshilfe.t-online.de",
+                "suche.t-online.de"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "TalkTalk": {
+            "domains": [
+                "www.talktalk.co.uk"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Technorati": {
+            "domains": [
+                "technorati.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Telstra": {
+            "domains": [
+                "search.media.telstra.com.au"
+            ],
+            "parameters": [
+                "find"
+            ]
+        },
+        "Teoma": {
+            "domains": [
+                "www.teoma.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Terra": {
+            "domains": [
+                "buscador.terra.cl",
+                "buscador.terra.com.br",
+                "buscador.terra.es"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "The Smart Search": {
+            "domains": [
+                "thesmartsearch.net",
+                "www.thesmartsearch.net"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Tiscali": {
+            "domains": [
+                "hledani.tiscali.cz",
+                "search-dyn.tiscali.it",
+                "search.tiscali.it"
+            ],
+            "parameters": [
+                "q",
+                "key"
+            ]
+        },
+        "Tixuma": {
+            "domains": [
+                "www.tixuma.de"
+            ],
+            "parameters": [
+                "sc"
+            ]
+        },
+        "Toolbarhome": {
+            "domains": [
+                "vshare.toolbarhome.com",
+                "www.toolbarhome.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Trouvez.com": {
+            "domains": [
+                "www.trouvez.com"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "TrovaRapido": {
+            "domains": [
+                "www.trovarapido.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Trusted-Search": {
+            "domains": [
+                "www.trusted--search.com"
+            ],
+            "parameters": [
+                "w"
+            ]
+        },
+        "Tut.by": {
+            "domains": [
+                "search.tut.by"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Twingly": {
+            "domains": [
+                "www.twingly.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "UKR.net": {
+            "domains": [
+                "search.ukr.net"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "URL.ORGanizier": {
+            "domains": [
+                "www.url.org"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Vi-view": {
+            "domains": [
+                "viview.inspsearch.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Vinden": {
+            "domains": [
+                "www.vinden.nl"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Vindex": {
+            "domains": [
+                "search.vindex.nl",
+                "www.vindex.nl"
+            ],
+            "parameters": [
+                "search_for"
+            ]
+        },
+        "Virgilio": {
+            "domains": [
+                "mobile.virgilio.it",
+                "ricerca.virgilio.it",
+                "ricercaimmagini.virgilio.it",
+                "ricercanews.virgilio.it",
+                "ricercavideo.virgilio.it"
+            ],
+            "parameters": [
+                "qs"
+            ]
+        },
+        "Voila": {
+            "domains": [
+                "search.ke.voila.fr",
+                "www.lemoteur.fr"
+            ],
+            "parameters": [
+                "rdata",
+                "kw"
+            ]
+        },
+        "Volny": {
+            "domains": [
+                "web.volny.cz"
+            ],
+            "parameters": [
+                "search"
+            ]
+        },
+        "WWW": {
+            "domains": [
+                "search.www.ee"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Walhello": {
+            "domains": [
+                "www.walhello.com",
+                "www.walhello.de",
+                "www.walhello.info",
+                "www.walhello.nl"
+            ],
+            "parameters": [
+                "key"
+            ]
+        },
+        "Web.de": {
+            "domains": [
+                "suche.web.de"
+            ],
+            "parameters": [
+                "su"
+            ]
+        },
+        "Web.nl": {
+            "domains": [
+                "www.web.nl"
+            ],
+            "parameters": [
+                "zoekwoord"
+            ]
+        },
+        "WebSearch": {
+            "domains": [
+                "www.websearch.com"
+            ],
+            "parameters": [
+                "qkw",
+                "q"
+            ]
+        },
+        "Weborama": {
+            "domains": [
+                "www.weborama.com"
+            ],
+            "parameters": [
+                "QUERY"
+            ]
+        },
+        "Winamp": {
+            "domains": [
+                "search.winamp.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Wirtualna Polska": {
+            "domains": [
+                "szukaj.wp.pl"
+            ],
+            "parameters": [
+                "szukaj"
+            ]
+        },
+        "Witch": {
+            "domains": [
+                "www.witch.de"
+            ],
+            "parameters": [
+                "search"
+            ]
+        },
+        "X-recherche": {
+            "domains": [
+                "www.x-recherche.com"
+            ],
+            "parameters": [
+                "MOTS"
+            ]
+        },
+        "Yahoo!": {
+            "domains": [
+                "*.search.yahoo.com",
+                "ar.yahoo.com",
+                "au.yahoo.com",
+                "br.yahoo.com",
+                "cade.searchde.yahoo.com",
+                "cade.yahoo.com",
+                "chinese.searchinese.yahoo.com",
+                "chinese.yahoo.com",
+                "cn.yahoo.com",
+                "de.yahoo.com",
+                "detail.chiebukuro.yahoo.co.jp",
+                "dk.yahoo.com",
+                "es.yahoo.com",
+                "espanol.searchpanol.yahoo.com",
+                "espanol.yahoo.com",
+                "fr.yahoo.com",
+                "ie.yahoo.com",
+                "it.yahoo.com",
+                "kr.yahoo.com",
+                "m.chiebukuro.yahoo.co.jp",
+                "mx.yahoo.com",
+                "no.yahoo.com",
+                "nz.yahoo.com",
+                "one.cn.yahoo.com",
+                "one.searchn.yahoo.com",
+                "qc.yahoo.com",
+                "se.yahoo.com",
+                "search.offerbox.com",
+                "search.searcharch.yahoo.com",
+                "search.yahoo.co.jp",
+                "search.yahoo.com",
+                "uk.yahoo.com",
+                "www.cercato.it",
+                "www.yahoo.co.jp",
+                "yahoo.com",
+                "ys.mirostart.com"
+            ],
+            "parameters": [
+                "p",
+                "q"
+            ],
+            "paid_paths": [
+                "r.search.yahoo.com/cbclk"
+            ]
+        },
+        "Yahoo! Images": {
+            "domains": [
+                "image.search.yahoo.co.jp",
+                "image.yahoo.cn",
+                "images.search.yahoo.com"
+            ],
+            "parameters": [
+                "p",
+                "q"
+            ]
+        },
+        "Yam": {
+            "domains": [
+                "search.yam.com"
+            ],
+            "parameters": [
+                "k"
+            ]
+        },
+        "Yandex": {
+            "domains": [
+                "www.yandex.by",
+                "www.yandex.com",
+                "www.yandex.ru",
+                "www.yandex.ua",
+                "yandex.by",
+                "yandex.com",
+                "yandex.ru",
+                "yandex.ua"
+            ],
+            "parameters": [
+                "text"
+            ]
+        },
+        "Yandex Images": {
+            "domains": [
+                "images.yandex.com",
+                "images.yandex.ru",
+                "images.yandex.ua"
+            ],
+            "parameters": [
+                "text"
+            ]
+        },
+        "Yasni": {
+            "domains": [
+                "www.yasni.at",
+                "www.yasni.ch",
+                "www.yasni.co.uk",
+                "www.yasni.com",
+                "www.yasni.de"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "Yatedo": {
+            "domains": [
+                "www.yatedo.com",
+                "www.yatedo.fr"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Yellowpages": {
+            "domains": [
+                "www.yellowpages.ca",
+                "www.yellowpages.com",
+                "www.yellowpages.com.au"
+            ],
+            "parameters": [
+                "q",
+                "search_terms"
+            ]
+        },
+        "Yippy": {
+            "domains": [
+                "search.yippy.com"
+            ],
+            "parameters": [
+                "q",
+                "query"
+            ]
+        },
+        "YouGoo": {
+            "domains": [
+                "www.yougoo.fr"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Zapmeta": {
+            "domains": [
+                "uk.zapmeta.com",
+                "www.zapmeta.com",
+                "www.zapmeta.de",
+                "www.zapmeta.nl"
+            ],
+            "parameters": [
+                "q",
+                "query"
+            ]
+        },
+        "Zhongsou": {
+            "domains": [
+                "p.zhongsou.com"
+            ],
+            "parameters": [
+                "w"
+            ]
+        },
+        "Zoek": {
+            "domains": [
+                "www3.zoek.nl"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Zoeken": {
+            "domains": [
+                "www.zoeken.nl"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "Zoohoo": {
+            "domains": [
+                "zoohoo.cz"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "all.by": {
+            "domains": [
+                "all.by"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "arama": {
+            "domains": [
+                "arama.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "blekko": {
+            "domains": [
+                "blekko.com"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "canoe.ca": {
+            "domains": [
+                "web.canoe.ca"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "dmoz": {
+            "domains": [
+                "dmoz.org",
+                "editors.dmoz.org"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "earthlink": {
+            "domains": [
+                "search.earthlink.net"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "eo": {
+            "domains": [
+                "eo.st"
+            ],
+            "parameters": [
+                "x_query"
+            ]
+        },
+        "goo": {
+            "domains": [
+                "ocnsearch.goo.ne.jp",
+                "search.goo.ne.jp"
+            ],
+            "parameters": [
+                "MT"
+            ]
+        },
+        "maailm": {
+            "domains": [
+                "www.maailm.com"
+            ],
+            "parameters": [
+                "tekst"
+            ]
+        },
+        "qip": {
+            "domains": [
+                "search.qip.ru"
+            ],
+            "parameters": [
+                "query"
+            ]
+        },
+        "suche.info": {
+            "domains": [
+                "suche.info"
+            ],
+            "parameters": [
+                "q"
+            ]
+        },
+        "uol.com.br": {
+            "domains": [
+                "busca.uol.com.br"
+            ],
+            "parameters": [
+                "q"
+            ]
+        }
+    },
+    "social": {
+        "Badoo": {
+            "domains": [
+                "badoo.com"
+            ]
+        },
+        "Bebo": {
+            "domains": [
+                "bebo.com"
+            ]
+        },
+        "BlackPlanet": {
+            "domains": [
+                "blackplanet.com"
+            ]
+        },
+        "Bloglovin'": {
+            "domains": [
+                "bloglovin.com"
+            ]
+        },
+        "Buzznet": {
+            "domains": [
+                "buzznet.com",
+                "wayn.com"
+            ]
+        },
+        "Classmates": {
+            "domains": [
+                "classmates.com"
+            ]
+        },
+        "Cyworld": {
+            "domains": [
+                "global.cyworld.com"
+            ]
+        },
+        "Delicious": {
+            "domains": [
+                "delicious.com"
+            ]
+        },
+        "DeviantArt": {
+            "domains": [
+                "deviantart.com"
+            ]
+        },
+        "Discus": {
+            "domains": [
+                "disq.us",
+                "disqus.com",
+                "redirect.disqus.com"
+            ]
+        },
+        "Donanimhaber": {
+            "domains": [
+                "donanimhaber.com"
+            ]
+        },
+        "Douban": {
+            "domains": [
+                "douban.com"
+            ]
+        },
+        "Eksi Sozluk": {
+            "domains": [
+                "sourtimes.org",
+                "sozluk.com"
+            ]
+        },
+        "Facebook": {
+            "domains": [
+                "facebook.com",
+                "fb.me",
+                "l.facebook.com",
+                "lm.facebook.com",
+                "m.facebook.com"
+            ],
+            "paid_parameters": [
+                "ad_id",
+                "adset_id",
+                "campaign_id"
+            ]
+        },
+        "Flickr": {
+            "domains": [
+                "flickr.com"
+            ]
+        },
+        "Flipboard": {
+            "domains": [
+                "flipboard.com"
+            ]
+        },
+        "Flixster": {
+            "domains": [
+                "flixster.com"
+            ]
+        },
+        "Fotolog": {
+            "domains": [
+                "fotolog.com"
+            ]
+        },
+        "Foursquare": {
+            "domains": [
+                "foursquare.com"
+            ]
+        },
+        "Friends Reunited": {
+            "domains": [
+                "friendsreunited.com"
+            ]
+        },
+        "Friendster": {
+            "domains": [
+                "friendster.com"
+            ]
+        },
+        "Gaia Online": {
+            "domains": [
+                "gaiaonline.com"
+            ]
+        },
+        "Geni": {
+            "domains": [
+                "geni.com"
+            ]
+        },
+        "GitHub": {
+            "domains": [
+                "github.com"
+            ]
+        },
+        "Google+": {
+            "domains": [
+                "plus.google.com",
+                "plus.url.google.com",
+                "url.google.com"
+            ]
+        },
+        "Habbo": {
+            "domains": [
+                "habbo.com"
+            ]
+        },
+        "Hacker News": {
+            "domains": [
+                "news.ycombinator.com"
+            ]
+        },
+        "Hocam.com": {
+            "domains": [
+                "hocam.com"
+            ]
+        },
+        "Hyves": {
+            "domains": [
+                "hyves.nl"
+            ]
+        },
+        "ITU Sozluk": {
+            "domains": [
+                "itusozluk.com"
+            ]
+        },
+        "Iconosquare": {
+            "domains": [
+                "iconosquare.com"
+            ]
+        },
+        "Identi.ca": {
+            "domains": [
+                "identi.ca"
+            ]
+        },
+        "Imgur": {
+            "domains": [
+                "imgur.com"
+            ]
+        },
+        "Inci Sozluk": {
+            "domains": [
+                "inci.sozlukspot.com",
+                "incisozluk.cc",
+                "incisozluk.com"
+            ]
+        },
+        "Instagram": {
+            "domains": [
+                "instagram.com",
+                "l.instagram.com"
+            ],
+            "paid_parameters": [
+                "ad_id",
+                "adset_id",
+                "campaign_id"
+            ]
+        },
+        "Instela": {
+            "domains": [
+                "instela.com"
+            ]
+        },
+        "KakaoTalk": {
+            "domains": [
+                "kakao.com"
+            ]
+        },
+        "LINE": {
+            "domains": [
+                "line.me"
+            ]
+        },
+        "Last.fm": {
+            "domains": [
+                "lastfm.ru"
+            ]
+        },
+        "LinkedIn": {
+            "domains": [
+                "linkedin.com",
+                "lnkd.in"
+            ]
+        },
+        "LiveJournal": {
+            "domains": [
+                "livejournal.ru"
+            ]
+        },
+        "Mail.ru": {
+            "domains": [
+                "my.mail.ru"
+            ]
+        },
+        "Medium": {
+            "domains": [
+                "medium.com"
+            ]
+        },
+        "Meetup": {
+            "domains": [
+                "meetup.com"
+            ]
+        },
+        "Messenger": {
+            "domains": [
+                "messenger.com"
+            ]
+        },
+        "Mixi": {
+            "domains": [
+                "mixi.jp"
+            ]
+        },
+        "MoiKrug.ru": {
+            "domains": [
+                "moikrug.ru"
+            ]
+        },
+        "Multiply": {
+            "domains": [
+                "multiply.com"
+            ]
+        },
+        "MyHeritage": {
+            "domains": [
+                "myheritage.com"
+            ]
+        },
+        "MyLife": {
+            "domains": [
+                "mylife.ru"
+            ]
+        },
+        "Myspace": {
+            "domains": [
+                "myspace.com"
+            ]
+        },
+        "Nasza-klasa.pl": {
+            "domains": [
+                "nk.pl"
+            ]
+        },
+        "Netlog": {
+            "domains": [
+                "netlog.com"
+            ]
+        },
+        "Odnoklassniki": {
+            "domains": [
+                "odnoklassniki.ru",
+                "ok.ru"
+            ]
+        },
+        "Orkut": {
+            "domains": [
+                "orkut.com"
+            ]
+        },
+        "Paper.li": {
+            "domains": [
+                "paper.li"
+            ]
+        },
+        "Pinterest": {
+            "domains": [
+                "pinterest.com"
+            ]
+        },
+        "Plaxo": {
+            "domains": [
+                "plaxo.com"
+            ]
+        },
+        "Pocket": {
+            "domains": [
+                "getpocket.com"
+            ]
+        },
+        "Polyvore": {
+            "domains": [
+                "polyvore.com"
+            ]
+        },
+        "Quora": {
+            "domains": [
+                "quora.com"
+            ]
+        },
+        "Qzone": {
+            "domains": [
+                "qzone.qq.com"
+            ]
+        },
+        "Reddit": {
+            "domains": [
+                "reddit.com"
+            ]
+        },
+        "Renren": {
+            "domains": [
+                "renren.com"
+            ]
+        },
+        "Skyrock": {
+            "domains": [
+                "skyrock.com"
+            ]
+        },
+        "Snapchat": {
+            "domains": [
+                "snapchat.com"
+            ]
+        },
+        "Sonico.com": {
+            "domains": [
+                "sonico.com"
+            ]
+        },
+        "SourceForge": {
+            "domains": [
+                "sourceforge.net"
+            ]
+        },
+        "StackOverflow": {
+            "domains": [
+                "stackoverflow.com"
+            ]
+        },
+        "StudiVZ": {
+            "domains": [
+                "studivz.net"
+            ]
+        },
+        "StumbleUpon": {
+            "domains": [
+                "stumbleupon.com"
+            ]
+        },
+        "Tagged": {
+            "domains": [
+                "login.tagged.com"
+            ]
+        },
+        "Taringa!": {
+            "domains": [
+                "taringa.net"
+            ]
+        },
+        "Telegram": {
+            "domains": [
+                "t.me",
+                "telegram.me",
+                "telegram.org"
+            ]
+        },
+        "Threads": {
+            "domains": [
+                "threads.com",
+                "threads.net"
+            ]
+        },
+        "TikTok": {
+            "domains": [
+                "tiktok.com",
+                "tiktokcdn.com"
+            ]
+        },
+        "Tuenti": {
+            "domains": [
+                "tuenti.com"
+            ]
+        },
+        "Tumblr": {
+            "domains": [
+                "t.umblr.com",
+                "tumblr.com",
+                "umblr.com"
+            ]
+        },
+        "Twitch": {
+            "domains": [
+                "twitch.com",
+                "twitch.tv"
+            ]
+        },
+        "Twitter": {
+            "domains": [
+                "t.co",
+                "twitter.com"
+            ]
+        },
+        "Uludag Sozluk": {
+            "domains": [
+                "uludagsozluk.com",
+                "ulusozluk.com"
+            ]
+        },
+        "Viadeo": {
+            "domains": [
+                "viadeo.com"
+            ]
+        },
+        "Vimeo": {
+            "domains": [
+                "vimeo.com"
+            ]
+        },
+        "Vkontakte": {
+            "domains": [
+                "vk.com",
+                "vkontakte.ru"
+            ]
+        },
+        "Wanelo": {
+            "domains": [
+                "wanelo.com"
+            ]
+        },
+        "WeChat": {
+            "domains": [
+                "wechat.com",
+                "weixin.qq.com"
+            ]
+        },
+        "WeeWorld": {
+            "domains": [
+                "weeworld.com"
+            ]
+        },
+        "Weibo": {
+            "domains": [
+                "t.cn",
+                "weibo.com"
+            ]
+        },
+        "WhatsApp": {
+            "domains": [
+                "wa.me",
+                "whatsapp.com"
+            ]
+        },
+        "Whirlpool": {
+            "domains": [
+                "forums.whirlpool.net.au"
+            ]
+        },
+        "Windows Live Spaces": {
+            "domains": [
+                "login.live.com"
+            ]
+        },
+        "XING": {
+            "domains": [
+                "xing.com"
+            ]
+        },
+        "Xanga": {
+            "domains": [
+                "xanga.com"
+            ]
+        },
+        "Youtube": {
+            "domains": [
+                "youtu.be",
+                "youtube.com"
+            ]
+        },
+        "hi5": {
+            "domains": [
+                "hi5.com"
+            ]
+        },
+        "myYearbook": {
+            "domains": [
+                "myyearbook.com"
+            ]
+        },
+        "vKruguDruzei.ru": {
+            "domains": [
+                "vkrugudruzei.ru"
+            ]
+        }
+    },
+    "unknown": {
+        "Google": {
+            "domains": [
+                "accounts.google.com",
+                "developers.google.com",
+                "drive.google.com",
+                "groups.google.co.uk",
+                "groups.google.com",
+                "maps.google.*",
+                "sites.google.com",
+                "support.google.com"
+            ]
+        },
+        "Slack": {
+            "domains": [
+                "slack-redir.net",
+                "slack.com"
+            ]
+        },
+        "Yahoo!": {
+            "domains": [
+                "answers.yahoo.com",
+                "astrology.yahoo.com",
+                "cars.yahoo.com",
+                "eurosport.yahoo.com",
+                "finance.yahoo.com",
+                "games.yahoo.com",
+                "lifestyle.yahoo.com",
+                "match.yahoo.net",
+                "messenger.yahoo.com",
+                "movies.yahoo.com",
+                "news.yahoo.com",
+                "omg.yahoo.com",
+                "screen.yahoo.com",
+                "shopping.yahoo.net",
+                "sports.yahoo.com",
+                "travel.yahoo.com",
+                "weather.yahoo.com"
+            ]
+        }
+    }
+}
+`
@@ -0,0 +1,105 @@
+package goreferrer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInAppBrowserUserAgents(t *testing.T) {
+	cases := []struct {
+		agent string
+		label string
+		app   string
+	}{
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Instagram 307.0.0.34.111 (iPhone15,3; iOS 17_1; en_US; en; scale=3.00; 1290x2796; 531842154)", "Instagram", "Instagram"},
+		{"Mozilla/5.0 (Linux; Android 13; SM-S918B Build/TP1A.220624.014; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/119.0.6045.66 Mobile Safari/537.36 Instagram 308.0.0.36.109 Android (33/13; 480dpi; 1080x2340; samsung; SM-S918B; dm3q; qcom; en_US; 532277102)", "Instagram", "Instagram"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 musical_ly_32.1.0 JsSdk/2.0 NetType/WIFI Channel/App Store ByteLocale/en Region/US", "TikTok", "TikTok"},
+		{"Mozilla/5.0 (Linux; Android 12; Pixel 6 Build/SD1A.210817.036; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/114.0.5735.196 Mobile Safari/537.36 trill_2023109010 JsSdk/1.0 NetType/WIFI Channel/googleplay AppName/trill app_version/31.9.1 BytedanceWebview/d8a21c6", "TikTok", "TikTok"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Snapchat/12.54.0.35 (like Safari/8615.3.12.10.4, panda)", "Snapchat", "Snapchat"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 16_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [LinkedInApp]/9.27.2478", "LinkedIn", "LinkedIn"},
+		{"Mozilla/5.0 (Linux; Android 11; SM-A515F Build/RP1A.200720.012; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/96.0.4664.45 Mobile Safari/537.36 WhatsApp/2.21.23.23", "WhatsApp", "WhatsApp"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 16_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [FBAN/MessengerForiOS;FBAV/388.0.0.26.109;FBBV/419617427;FBDV/iPhone13,2;FBMD/iPhone;FBSN/iOS;FBSV/16.0]", "Messenger", "Messenger"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 16_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 MicroMessenger/8.0.38(0x18002629) NetType/WIFI Language/zh_CN", "WeChat", "WeChat"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 16_3 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Safari Line/13.1.0", "LINE", "LINE"},
+		{"Mozilla/5.0 (Linux; Android 12; SM-G991N Build/SP1A.210812.016; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/110.0.5481.153 Mobile Safari/537.36;KAKAOTALK 2410200", "KakaoTalk", "KakaoTalk"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Barcelona 303.0.0.11.109 (iPhone14,5; iOS 17_0; en_US; en; scale=3.00; 1170x2532; 522766451)", "Threads", "Threads"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Reddit/Version 2023.37.0/Build 1229530/iOS Version 16.6", "Reddit", "Reddit"},
+		{"Mozilla/5.0 (Linux; Android 13; Pixel 7 Build/TQ3A.230901.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/116.0.5845.163 Mobile Safari/537.36 Telegram-Android/10.0.5 (Google Pixel 7; Android 13; SDK 33; HIGH)", "Telegram", "Telegram"},
+		{"Mozilla/5.0 (Linux; Android 6.0.1; SAMSUNG-SM-N910A Build/MMB29M; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/58.0.3029.83 Mobile Safari/537.36 [FB_IAB/FB4A;FBAV/127.0.0.1;]", "Facebook", "Facebook"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 7_0_4 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) Mobile/11B554a [Pinterest/iOS]", "Pinterest", "Pinterest"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 7_0_4 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) Mobile/11B554a Twitter for iPhone", "Twitter", "Twitter"},
+	}
+
+	for _, c := range cases {
+		actual := DefaultRules.ParseWith("", nil, c.agent)
+		assert.Equal(t, Social, actual.Type, c.agent)
+		assert.Equal(t, c.label, actual.Label, c.agent)
+		assert.Equal(t, c.app, actual.App, c.agent)
+	}
+}
+
+func TestGenericWebViewUserAgent(t *testing.T) {
+	actual := DefaultRules.ParseWith("", nil, "Mozilla/5.0 (Linux; Android 13; Pixel 7 Build/TQ3A.230901.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/116.0.5845.163 Mobile Safari/537.36")
+	assert.Equal(t, Direct, actual.Type)
+	assert.Equal(t, "Android WebView", actual.App)
+}
+
+func TestInAppBrowserWithReferrer(t *testing.T) {
+	actual := DefaultRules.ParseWith("https://www.example.org/products", nil, "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Instagram 307.0.0.34.111")
+	assert.Equal(t, Indirect, actual.Type)
+	assert.Equal(t, "Instagram", actual.App)
+}
+
+func TestDesktopUserAgentHasNoApp(t *testing.T) {
+	actual := DefaultRules.ParseWith("", nil, "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15")
+	assert.Equal(t, Direct, actual.Type)
+	assert.Equal(t, "", actual.App)
+}
+
+func TestAppReferrers(t *testing.T) {
+	cases := []struct {
+		url     string
+		typ     ReferrerType
+		label   string
+		appID   string
+		channel Channel
+	}{
+		{"android-app://com.google.android.gm/", Email, "Gmail", "com.google.android.gm", ChannelReferral},
+		{"android-app://com.google.android.googlequicksearchbox/https/www.google.com", Search, "Google", "com.google.android.googlequicksearchbox", ChannelOrganicSearch},
+		{"android-app://com.facebook.katana", Social, "Facebook", "com.facebook.katana", ChannelOrganicSocial},
+		{"android-app://com.instagram.android/", Social, "Instagram", "com.instagram.android", ChannelOrganicSocial},
+		{"android-app://com.linkedin.android/", Social, "LinkedIn", "com.linkedin.android", ChannelOrganicSocial},
+		{"ios-app://284882215/fb/profile", Social, "Facebook", "284882215", ChannelOrganicSocial},
+		{"ios-app://com.burbn.instagram/", Social, "Instagram", "com.burbn.instagram", ChannelOrganicSocial},
+		{"fb://profile/4", Social, "Facebook", "fb", ChannelOrganicSocial},
+	}
+	for _, c := range cases {
+		actual := DefaultRules.Parse(c.url)
+		assert.Equal(t, c.typ, actual.Type, c.url)
+		assert.Equal(t, c.label, actual.Label, c.url)
+		assert.Equal(t, c.appID, actual.AppID, c.url)
+		assert.Equal(t, AppHost, actual.HostKind, c.url)
+		assert.Equal(t, Match{Kind: AppRuleMatch, Rule: c.appID}, actual.Match, c.url)
+		assert.Equal(t, c.channel, ChannelGroup(actual), c.url)
+		assert.Equal(t, actual, DefaultClassifier.Parse(c.url), c.url)
+	}
+}
+
+func TestUnknownAppReferrer(t *testing.T) {
+	actual := DefaultRules.Parse("android-app://com.example.Reader/")
+	expected := Referrer{
+		Type:     Indirect,
+		Label:    "com.example.reader",
+		URL:      "android-app://com.example.Reader/",
+		HostKind: AppHost,
+		Path:     "/",
+		AppID:    "com.example.reader",
+		Match:    Match{Kind: FallbackMatch},
+	}
+	assert.Equal(t, expected, actual)
+
+	_, err := DefaultRules.ParseE("android-app:///")
+	assert.True(t, errors.Is(err, ErrMissingHost))
+}
@@ -5,6 +5,8 @@ go 1.22.1
 require (
 	github.com/stretchr/testify v1.2.1
 	golang.org/x/net v0.0.0-20180218175443-cbe0f9307d01
+	golang.org/x/text v0.14.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -0,0 +1,229 @@
+package goreferrer
+
+import (
+	"net/url"
+	"strings"
+)
+
+type Campaign struct {
+	Source  string
+	Medium  string
+	Name    string
+	Term    string
+	Content string
+}
+
+type ClickID struct {
+	Param string
+	Value string
+}
+
+type clickIDRule struct {
+	Param string
+	Type  ReferrerType
+	Label string
+	Paid  bool
+}
+
+// clickIDRules is ordered by precedence, the first click ID found on the
+// landing page is the one used to reconcile the referrer.
+var clickIDRules = []clickIDRule{
+	{Param: "gclid", Type: Search, Label: "Google", Paid: true},
+	{Param: "gbraid", Type: Search, Label: "Google", Paid: true},
+	{Param: "wbraid", Type: Search, Label: "Google", Paid: true},
+	{Param: "msclkid", Type: Search, Label: "Bing", Paid: true},
+	{Param: "dclid", Type: Indirect, Label: "Google Display", Paid: true},
+	{Param: "ttclid", Type: Social, Label: "TikTok", Paid: true},
+	{Param: "twclid", Type: Social, Label: "Twitter", Paid: true},
+	{Param: "li_fat_id", Type: Social, Label: "LinkedIn", Paid: true},
+	{Param: "ScCid", Type: Social, Label: "Snapchat", Paid: true},
+	{Param: "epik", Type: Social, Label: "Pinterest", Paid: true},
+	{Param: "rdt_cid", Type: Social, Label: "Reddit", Paid: true},
+	{Param: "fbclid", Type: Social, Label: "Facebook"},
+}
+
+var paidMediums = []string{"cpc", "ppc", "cpm", "cpv", "cpa", "paid", "paidsearch", "paid-search", "paid_search", "paidsocial", "paid-social", "paid_social", "display", "banner", "retargeting"}
+
+func (r RuleSet) ParseWithLanding(URL, landing string, domains []string, agent string) Referrer {
+	return parseWithLanding(r, URL, landing, domains, agent)
+}
+
+func parseWithLanding(m ruleMatcher, URL, landing string, domains []string, agent string) Referrer {
+	ref, _ := parseWith(m, URL, domains, agent)
+	values := parseLandingQuery(landing)
+	if values == nil {
+		return ref
+	}
+
+	ref.Campaign = Campaign{
+		Source:  values.Get("utm_source"),
+		Medium:  values.Get("utm_medium"),
+		Name:    values.Get("utm_campaign"),
+		Term:    values.Get("utm_term"),
+		Content: values.Get("utm_content"),
+	}
+
+	var clickRule *clickIDRule
+	for i, rule := range clickIDRules {
+		value := values.Get(rule.Param)
+		if value == "" {
+			continue
+		}
+		if clickRule == nil {
+			clickRule = &clickIDRules[i]
+		}
+		ref.ClickIDs = append(ref.ClickIDs, ClickID{Param: rule.Param, Value: value})
+	}
+
+	if clickRule != nil {
+		applyClickID(&ref, *clickRule)
+	}
+	applyPaidParameters(m, URL, &ref, values)
+	applySource(m, &ref)
+	applyCampaign(&ref)
+
+	ref.GoogleType = googleSearchType(ref)
+	return ref
+}
+
+func parseLandingQuery(landing string) url.Values {
+	landing = strings.Trim(landing, " \t\r\n")
+	if landing == "" {
+		return nil
+	}
+
+	u, err := url.Parse(landing)
+	if err != nil {
+		return nil
+	}
+
+	return u.Query()
+}
+
+func applyClickID(ref *Referrer, rule clickIDRule) {
+	switch {
+	case ref.Type == rule.Type && strings.Contains(ref.Label, rule.Label):
+	case ref.Type == Direct || ref.Type == Indirect || ref.Type == Invalid:
+		dropHost(ref)
+		retype(ref, rule.Type)
+		ref.Label = rule.Label
+		ref.Match = Match{Kind: LandingMatch, Rule: rule.Param}
+	default:
+		return
+	}
+
+	if rule.Paid {
+		ref.Paid = true
+	}
+}
+
+// applyPaidParameters marks the referrer paid when the landing page carries
+// one of its rule's paid parameters, since ad platforms like Facebook tag the
+// link to the site rather than their own pages.
+func applyPaidParameters(m ruleMatcher, URL string, ref *Referrer, values url.Values) {
+	u, ok := parseRichUrl(URL)
+	if !ok || u.HostKind != DomainHost {
+		return
+	}
+
+	rule, _, exists := m.getDomainRule(u)
+	if !exists || rule.Label != ref.Label {
+		return
+	}
+	for _, param := range rule.PaidParameters {
+		if values.Get(param) != "" {
+			ref.Paid = true
+			return
+		}
+	}
+}
+
+// applySource classifies visits by a utm_source naming an AI assistant, such
+// as the utm_source=chatgpt.com ChatGPT adds to its links, since assistants
+// rarely send a referrer.
+func applySource(m ruleMatcher, ref *Referrer) {
+	if ref.Type != Direct && ref.Type != Indirect && ref.Type != Invalid {
+		return
+	}
+
+	u, ok := parseRichUrl(strings.ToLower(ref.Campaign.Source))
+	if !ok || u.HostKind != DomainHost {
+		return
+	}
+	if rule, _, exists := m.getDomainRule(u); exists && rule.Type == AI {
+		dropHost(ref)
+		retype(ref, AI)
+		ref.Label = rule.Label
+		ref.Match = Match{Kind: LandingMatch, Rule: "utm_source"}
+	}
+}
+
+// applyCampaign applies the email and social mediums of the default channel
+// rules. An email medium overrides any other referrer, which is then named by
+// its utm_source.
+func applyCampaign(ref *Referrer) {
+	medium := strings.ToLower(ref.Campaign.Medium)
+	switch {
+	case DefaultChannelRules.mediumIs("email", medium):
+		if ref.Type != Email {
+			dropHost(ref)
+			retype(ref, Email)
+			ref.Label = ref.Campaign.Source
+			ref.Match = Match{Kind: LandingMatch, Rule: "utm_medium"}
+		}
+	case DefaultChannelRules.mediumIs("social", medium):
+		if ref.Type == Direct || ref.Type == Indirect || ref.Type == Invalid {
+			retype(ref, Social)
+			ref.Match = Match{Kind: LandingMatch, Rule: "utm_medium"}
+		}
+	case containsString(paidMediums, medium):
+		ref.Paid = true
+	default:
+		return
+	}
+
+	if ref.Label == "" {
+		ref.Label = ref.Campaign.Source
+	}
+}
+
+// retype changes the referrer's type, resetting the fields only Search and AI
+// referrers have. The query of a source known from the landing page alone is
+// not provided.
+func retype(ref *Referrer, Type ReferrerType) {
+	ref.Type = Type
+	ref.Query = ""
+	ref.QueryStatus = QueryNotApplicable
+	ref.QueryCharset = ""
+	ref.Vertical = NotSearch
+	ref.Search = nil
+	switch Type {
+	case Search:
+		ref.QueryStatus = QueryNotProvided
+		ref.Vertical = WebSearch
+	case AI:
+		ref.QueryStatus = QueryNotProvided
+	}
+}
+
+// dropHost clears the referrer's host when the landing page attributes the
+// visit to another source.
+func dropHost(ref *Referrer) {
+	ref.Subdomain = ""
+	ref.Domain = ""
+	ref.Tld = ""
+	ref.Port = ""
+	ref.HostKind = DomainHost
+	ref.Path = ""
+	ref.AppID = ""
+}
+
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,161 @@
+package goreferrer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLandingExtractsCampaign(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("http://walrus.com/", "https://shop.example.com/?utm_source=walrus&utm_medium=referral&utm_campaign=spring&utm_term=boots&utm_content=banner", nil, "")
+	expected := Campaign{
+		Source:  "walrus",
+		Medium:  "referral",
+		Name:    "spring",
+		Term:    "boots",
+		Content: "banner",
+	}
+	assert.Equal(t, expected, actual.Campaign)
+	assert.Equal(t, Indirect, actual.Type)
+	assert.Equal(t, "Walrus", actual.Label)
+}
+
+func TestLandingGclidUpgradesGoogleOrganic(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("https://www.google.com/", "https://shop.example.com/products/boots?gclid=abc123", nil, "")
+	expected := Referrer{
+		Type:        Search,
+		Label:       "Google",
+		URL:         "https://www.google.com/",
+		Subdomain:   "www",
+		Domain:      "google",
+		Tld:         "com",
+		Path:        "/",
+		QueryStatus: QueryNotProvided,
+		Paid:        true,
+		GoogleType:  Adwords,
+		Vertical:    WebSearch,
+		ClickIDs:    []ClickID{{Param: "gclid", Value: "abc123"}},
+		Match:       Match{Kind: DomainRuleMatch, Rule: "www.google.*", Variation: PublicSuffixWildcardVariation},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestLandingClickIDReplacesIndirectHost(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("http://www.walrus.com/boots", "https://shop.example.com/?gclid=abc123", nil, "")
+	expected := Referrer{
+		Type:        Search,
+		Label:       "Google",
+		URL:         "http://www.walrus.com/boots",
+		QueryStatus: QueryNotProvided,
+		Paid:        true,
+		GoogleType:  Adwords,
+		Vertical:    WebSearch,
+		ClickIDs:    []ClickID{{Param: "gclid", Value: "abc123"}},
+		Match:       Match{Kind: LandingMatch, Rule: "gclid"},
+	}
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, "", actual.Host())
+}
+
+func TestLandingClickIDWithoutReferrer(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("", "https://shop.example.com/?msclkid=xyz", nil, "")
+	assert.Equal(t, Search, actual.Type)
+	assert.Equal(t, "Bing", actual.Label)
+	assert.True(t, actual.Paid)
+	assert.Equal(t, NotGoogleSearch, actual.GoogleType)
+}
+
+func TestLandingFbclidIsNotPaid(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("https://l.facebook.com/", "https://shop.example.com/?fbclid=IwAR0", nil, "")
+	assert.Equal(t, Social, actual.Type)
+	assert.Equal(t, "Facebook", actual.Label)
+	assert.False(t, actual.Paid)
+}
+
+func TestLandingPaidSocialParameters(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("https://l.facebook.com/", "https://shop.example.com/?fbclid=IwAR0&ad_id=2384&adset_id=2383", nil, "")
+	assert.Equal(t, Social, actual.Type)
+	assert.Equal(t, "Facebook", actual.Label)
+	assert.True(t, actual.Paid)
+
+	actual = DefaultRules.ParseWithLanding("https://www.instagram.com/", "https://shop.example.com/?campaign_id=2381", nil, "")
+	assert.Equal(t, "Instagram", actual.Label)
+	assert.True(t, actual.Paid)
+
+	actual = DefaultRules.ParseWithLanding("https://twitter.com/", "https://shop.example.com/?ad_id=2384", nil, "")
+	assert.False(t, actual.Paid)
+
+	actual = DefaultRules.ParseWithLanding("", "https://shop.example.com/?epik=dj0yJnU9", nil, "")
+	assert.Equal(t, Social, actual.Type)
+	assert.Equal(t, "Pinterest", actual.Label)
+	assert.True(t, actual.Paid)
+}
+
+func TestLandingClickIDDoesNotOverrideOtherNetwork(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("https://twitter.com/", "https://shop.example.com/?gclid=abc123", nil, "")
+	assert.Equal(t, Social, actual.Type)
+	assert.Equal(t, "Twitter", actual.Label)
+	assert.False(t, actual.Paid)
+}
+
+func TestLandingClickIDPrecedence(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("", "https://shop.example.com/?fbclid=IwAR0&gclid=abc123", nil, "")
+	assert.Equal(t, "Google", actual.Label)
+	assert.Equal(t, []ClickID{{Param: "gclid", Value: "abc123"}, {Param: "fbclid", Value: "IwAR0"}}, actual.ClickIDs)
+}
+
+func TestLandingEmailMediumForcesEmail(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("", "https://shop.example.com/?utm_source=newsletter&utm_medium=Email", nil, "")
+	assert.Equal(t, Email, actual.Type)
+	assert.Equal(t, "newsletter", actual.Label)
+
+	actual = DefaultRules.ParseWithLanding("http://walrus.com/", "https://shop.example.com/?utm_medium=email", nil, "")
+	assert.Equal(t, Email, actual.Type)
+	assert.Equal(t, "", actual.Label)
+	assert.Equal(t, "", actual.Domain)
+}
+
+func TestLandingEmailMediumClearsSearchFields(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("https://www.google.com/search?q=boots&tbm=isch&gl=us", "https://shop.example.com/?utm_source=newsletter&utm_medium=email", nil, "")
+	expected := Referrer{
+		Type:     Email,
+		Label:    "newsletter",
+		URL:      "https://www.google.com/search?q=boots&tbm=isch&gl=us",
+		Campaign: Campaign{Source: "newsletter", Medium: "email"},
+		Match:    Match{Kind: LandingMatch, Rule: "utm_medium"},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestLandingSocialMedium(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("", "https://shop.example.com/?utm_source=mastodon&utm_medium=social", nil, "")
+	assert.Equal(t, Social, actual.Type)
+	assert.Equal(t, "mastodon", actual.Label)
+}
+
+func TestLandingPaidMedium(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("https://www.google.com/", "https://shop.example.com/?utm_source=google&utm_medium=cpc", nil, "")
+	assert.Equal(t, Search, actual.Type)
+	assert.True(t, actual.Paid)
+	assert.Equal(t, Adwords, actual.GoogleType)
+}
+
+func TestLandingBlankOrInvalidIsIgnored(t *testing.T) {
+	expected := DefaultRules.Parse("https://www.google.com/")
+	assert.Equal(t, expected, DefaultRules.ParseWithLanding("https://www.google.com/", "", nil, ""))
+	assert.Equal(t, expected, DefaultRules.ParseWithLanding("https://www.google.com/", "http://[::1", nil, ""))
+}
+
+func TestLandingAISource(t *testing.T) {
+	actual := DefaultRules.ParseWithLanding("", "https://shop.example.com/products/boots?utm_source=chatgpt.com", nil, "")
+	assert.Equal(t, AI, actual.Type)
+	assert.Equal(t, "ChatGPT", actual.Label)
+	assert.Equal(t, Match{Kind: LandingMatch, Rule: "utm_source"}, actual.Match)
+	assert.Equal(t, actual, DefaultClassifier.ParseWithLanding("", "https://shop.example.com/products/boots?utm_source=chatgpt.com", nil, ""))
+
+	actual = DefaultRules.ParseWithLanding("https://www.google.com/", "https://shop.example.com/?utm_source=chatgpt.com", nil, "")
+	assert.Equal(t, Search, actual.Type)
+
+	actual = DefaultRules.ParseWithLanding("", "https://shop.example.com/?utm_source=newsletter", nil, "")
+	assert.Equal(t, Direct, actual.Type)
+}
@@ -0,0 +1,74 @@
+package goreferrer
+
+type MatchKind int
+
+const (
+	NoMatch MatchKind = iota
+	DomainRuleMatch
+	UaRuleMatch
+	DirectDomainMatch
+	FallbackMatch
+	LandingMatch
+	AppRuleMatch
+)
+
+func (m MatchKind) String() string {
+	switch m {
+	default:
+		return "none"
+	case DomainRuleMatch:
+		return "domain rule"
+	case UaRuleMatch:
+		return "user agent rule"
+	case DirectDomainMatch:
+		return "direct domain"
+	case FallbackMatch:
+		return "fallback"
+	case LandingMatch:
+		return "landing page"
+	case AppRuleMatch:
+		return "app rule"
+	}
+}
+
+type MatchVariation int
+
+const (
+	NoVariation MatchVariation = iota
+	HostPathVariation
+	RegisteredDomainPathVariation
+	HostVariation
+	RegisteredDomainVariation
+	SubdomainWildcardVariation
+	PublicSuffixWildcardVariation
+)
+
+func (m MatchVariation) String() string {
+	switch m {
+	default:
+		return "none"
+	case HostPathVariation:
+		return "host/path"
+	case RegisteredDomainPathVariation:
+		return "registered domain/path"
+	case HostVariation:
+		return "host"
+	case RegisteredDomainVariation:
+		return "registered domain"
+	case SubdomainWildcardVariation:
+		return "subdomain wildcard"
+	case PublicSuffixWildcardVariation:
+		return "public suffix wildcard"
+	}
+}
+
+// Match records which signal produced a referrer's classification. Rule is
+// the matched DomainRules or AppRules key, direct domain or landing page
+// parameter, and UaRule the UaRules key whose url stood in for a missing
+// referrer.
+type Match struct {
+	Kind      MatchKind
+	Rule      string
+	Variation MatchVariation
+	UaRule    string
+}
@@ -0,0 +1,98 @@
+package goreferrer
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/unicode/norm"
+)
+
+// charsetParameters are the parameters engines declare the encoding of their
+// query in, e.g. Baidu's ie=gbk and Yahoo!'s ei=UTF-8. Google uses ei for a
+// session id, so only known charset names are taken.
+var charsetParameters = []string{"ie", "ei", "charset"}
+
+func queryCharset(values url.Values) string {
+	for _, param := range charsetParameters {
+		charset := strings.ToLower(values.Get(param))
+		if _, err := htmlindex.Get(charset); err == nil {
+			return charset
+		}
+	}
+
+	return ""
+}
+
+// NormalizedQuery is NormalizeQuery applied to the referrer's query and
+// declared charset.
+func (r *Referrer) NormalizedQuery() string {
+	return NormalizeQuery(r.Query, r.QueryCharset)
+}
+
+// QueryTokens is TokenizeQuery applied to the referrer's query and declared
+// charset.
+func (r *Referrer) QueryTokens() []string {
+	return TokenizeQuery(r.Query, r.QueryCharset)
+}
+
+// NormalizeQuery prepares a search query for aggregation: it is decoded from
+// charset unless it already is valid UTF-8, NFKC normalized, lowercased and
+// runs of whitespace are collapsed into single spaces. Invalid or unknown
+// encodings leave replacement characters behind.
+func NormalizeQuery(query, charset string) string {
+	query = decodeQuery(query, charset)
+	query = strings.ToLower(norm.NFKC.String(query))
+	return strings.Join(strings.Fields(query), " ")
+}
+
+// TokenizeQuery splits a normalized query into words at anything but letters,
+// marks and numbers. Chinese and Japanese, which are written without spaces,
+// are split into single characters.
+func TokenizeQuery(query, charset string) []string {
+	var tokens []string
+	word := -1
+	query = NormalizeQuery(query, charset)
+	for i, r := range query {
+		switch {
+		case isIdeographic(r):
+			if word != -1 {
+				tokens = append(tokens, query[word:i])
+				word = -1
+			}
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsNumber(r):
+			if word == -1 {
+				word = i
+			}
+		case word != -1:
+			tokens = append(tokens, query[word:i])
+			word = -1
+		}
+	}
+	if word != -1 {
+		tokens = append(tokens, query[word:])
+	}
+
+	return tokens
+}
+
+func decodeQuery(query, charset string) string {
+	if utf8.ValidString(query) {
+		return query
+	}
+
+	if enc, err := htmlindex.Get(charset); err == nil {
+		if decoded, err := enc.NewDecoder().String(query); err == nil {
+			return decoded
+		}
+	}
+
+	return strings.ToValidUTF8(query, string(utf8.RuneError))
+}
+
+func isIdeographic(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r)
+}
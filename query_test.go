@@ -0,0 +1,44 @@
+package goreferrer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	cases := []struct {
+		query    string
+		charset  string
+		expected string
+	}{
+		{"  Leather   BOOTS\t", "", "leather boots"},
+		{"ＢＯＯＴＳ ﬁt", "", "boots fit"},
+		{`vinduespudsning JOKAPOLAR "11 + 11"`, "", `vinduespudsning jokapolar "11 + 11"`},
+		{"Ботинки", "", "ботинки"},
+		{"\xd6\xd0\xce\xc4", "gbk", "中文"},
+		{"\xc1\xee\xf2\xe8\xed\xea\xe8", "windows-1251", "ботинки"},
+		{"中文", "gbk", "中文"},
+		{"bad\xff", "", "bad�"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, NormalizeQuery(c.query, c.charset), c.query)
+	}
+}
+
+func TestTokenizeQuery(t *testing.T) {
+	assert.Equal(t, []string{"ботинки", "packer", "shoes"}, TokenizeQuery("Ботинки packer-shoes", ""))
+	assert.Equal(t, []string{"11", "11", "mkobetic"}, TokenizeQuery(`"11 + 11" mkobetic`, ""))
+	assert.Equal(t, []string{"nike", "运", "动", "鞋"}, TokenizeQuery("Nike运动鞋", ""))
+	assert.Empty(t, TokenizeQuery(" + ", ""))
+}
+
+func TestReferrerNormalizedQuery(t *testing.T) {
+	actual := DefaultRules.Parse("https://www.baidu.com/s?ie=gbk&wd=%D6%D0%CE%C4+%D0%AC")
+	assert.Equal(t, "gbk", actual.QueryCharset)
+	assert.Equal(t, "中文 鞋", actual.NormalizedQuery())
+	assert.Equal(t, []string{"中", "文", "鞋"}, actual.QueryTokens())
+
+	actual = DefaultRules.Parse("https://yandex.ru/search/?ie=cp1251&text=%C1%EE%F2%E8%ED%EA%E8")
+	assert.Equal(t, "ботинки", actual.NormalizedQuery())
+}
@@ -0,0 +1,117 @@
+package goreferrer
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+type RedirectEncoding int
+
+const (
+	QueryRedirect RedirectEncoding = iota
+	ProofpointRedirect
+)
+
+type RedirectRule struct {
+	Label      string
+	Parameters []string
+	Encoding   RedirectEncoding
+}
+
+// maxRedirectDepth bounds how many nested link wrappers are unwrapped, e.g.
+// a Proofpoint link rewritten again by Outlook SafeLinks.
+const maxRedirectDepth = 5
+
+// unwrapRedirects records the outermost link wrapper and the innermost url it
+// points at. The referrer is still classified by the wrapper, which is where
+// the visitor actually came from. It returns the parameter of the referrer
+// holding the url it points at, which is not a search query.
+func unwrapRedirects(m ruleMatcher, u *richUrl, ref *Referrer) (param string) {
+	for depth := 0; depth < maxRedirectDepth; depth++ {
+		rule, exists := m.getRedirectRule(u)
+		if !exists {
+			return param
+		}
+
+		if ref.Wrapper == "" {
+			ref.Wrapper = rule.Label
+		}
+
+		target, next, targetParam := redirectTarget(u, rule)
+		if target == "" {
+			return param
+		}
+		ref.UnwrappedURL = target
+		if depth == 0 {
+			param = targetParam
+		}
+
+		if next == nil || next.HostKind != DomainHost {
+			return param
+		}
+		u = next
+	}
+
+	return param
+}
+
+func (r RuleSet) getRedirectRule(u *richUrl) (RedirectRule, bool) {
+	rule, _, exists := findRule(r.RedirectRules, u)
+	return rule, exists
+}
+
+// redirectTarget returns the url a link wrapper points at along with its
+// parsed form, which is nil when the target can't be parsed, and the query
+// parameter it was read from.
+func redirectTarget(u *richUrl, rule RedirectRule) (string, *richUrl, string) {
+	if rule.Encoding == ProofpointRedirect {
+		target := proofpointTarget(u)
+		if target == "" {
+			return "", nil, ""
+		}
+		next, _ := parseRichUrl(target)
+		return target, next, ""
+	}
+
+	values := u.Query()
+	for _, param := range rule.Parameters {
+		target := values.Get(param)
+		if target == "" {
+			continue
+		}
+		if next, ok := parseRichUrl(target); ok {
+			return target, next, param
+		}
+	}
+
+	return "", nil, ""
+}
+
+var proofpointEscape = regexp.MustCompile(`-([0-9A-Fa-f]{2})`)
+
+// proofpointTarget decodes Proofpoint URL Defense links. Version 1 stores the
+// url in the u parameter as is, version 2 escapes it with "-XX" sequences and
+// underscores for slashes, and version 3 embeds it in the path between "__".
+func proofpointTarget(u *richUrl) string {
+	if strings.HasPrefix(u.Path, "/v3/__") {
+		target := strings.TrimPrefix(u.Path, "/v3/__")
+		if i := strings.LastIndex(target, "__"); i != -1 {
+			target = target[:i]
+		}
+		return target
+	}
+
+	target := u.Query().Get("u")
+	if strings.HasPrefix(u.Path, "/v2/") {
+		target = strings.Replace(target, "_", "/", -1)
+		target = proofpointEscape.ReplaceAllString(target, "%$1")
+		unescaped, err := url.PathUnescape(target)
+		if err != nil {
+			return ""
+		}
+		target = unescaped
+	}
+
+	return target
+}
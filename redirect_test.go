@@ -0,0 +1,76 @@
+package goreferrer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnwrapRedirects(t *testing.T) {
+	cases := []struct {
+		url       string
+		typ       ReferrerType
+		label     string
+		wrapper   string
+		unwrapped string
+	}{
+		{"https://lm.facebook.com/l.php?u=https%3A%2F%2Fshop.example.com%2F&h=AT0", Social, "Facebook", "Facebook", "https://shop.example.com/"},
+		{"https://l.instagram.com/?u=https%3A%2F%2Fshop.example.com%2Fboots&e=ATM", Social, "Instagram", "Instagram", "https://shop.example.com/boots"},
+		{"https://www.google.com/url?q=https://shop.example.com/&sa=D", Search, "Google", "Google", "https://shop.example.com/"},
+		{"https://t.co/abc123", Social, "Twitter", "Twitter", ""},
+		{"https://out.reddit.com/t3_abc?url=https%3A%2F%2Fshop.example.com%2F&token=x", Social, "Reddit", "Reddit", "https://shop.example.com/"},
+		{"https://nam12.safelinks.protection.outlook.com/?url=https%3A%2F%2Fshop.example.com%2Fsale&data=05", Email, "Outlook.com", "Outlook SafeLinks", "https://shop.example.com/sale"},
+		{"https://urldefense.proofpoint.com/v2/url?u=https-3A__shop.example.com_sale-3Fid-3D1&d=DwMF", Email, "Proofpoint", "Proofpoint", "https://shop.example.com/sale?id=1"},
+		{"https://urldefense.com/v3/__https://shop.example.com/sale__;!!abc!def$", Email, "Proofpoint", "Proofpoint", "https://shop.example.com/sale"},
+		{"https://protect-us.mimecast.com/s/abc123?domain=shop.example.com", Email, "Mimecast", "Mimecast", "shop.example.com"},
+		{"https://slack-redir.net/link?url=https%3A%2F%2Fshop.example.com%2F", Unknown, "Slack", "Slack", "https://shop.example.com/"},
+	}
+
+	for _, c := range cases {
+		actual := DefaultRules.Parse(c.url)
+		assert.Equal(t, c.typ, actual.Type, c.url)
+		assert.Equal(t, c.label, actual.Label, c.url)
+		assert.Equal(t, c.wrapper, actual.Wrapper, c.url)
+		assert.Equal(t, c.unwrapped, actual.UnwrappedURL, c.url)
+	}
+}
+
+func TestUnwrapNestedRedirects(t *testing.T) {
+	actual := DefaultRules.Parse("https://eur01.safelinks.protection.outlook.com/?url=https%3A%2F%2Furldefense.com%2Fv3%2F__https%3A%2F%2Fshop.example.com%2F__%3B!!x%24&data=05")
+	assert.Equal(t, "Outlook SafeLinks", actual.Wrapper)
+	assert.Equal(t, "https://shop.example.com/", actual.UnwrappedURL)
+}
+
+func TestUnwrapIgnoresNonUrlParameters(t *testing.T) {
+	actual := DefaultRules.Parse("https://www.google.com/url?q=boots&sa=t")
+	assert.Equal(t, "Google", actual.Wrapper)
+	assert.Equal(t, "", actual.UnwrappedURL)
+}
+
+func TestUnwrapDoesNotReportTargetAsQuery(t *testing.T) {
+	actual := DefaultRules.Parse("https://www.google.com/url?q=https://shop.example.com/&sa=D")
+	assert.Equal(t, "https://shop.example.com/", actual.UnwrappedURL)
+	assert.Equal(t, "", actual.Query)
+	assert.Equal(t, QueryNotProvided, actual.QueryStatus)
+
+	actual = DefaultRules.Parse("https://www.google.com/url?q=boots&sa=t")
+	assert.Equal(t, "boots", actual.Query)
+	assert.Equal(t, QueryPresent, actual.QueryStatus)
+}
+
+func TestNoRedirectRule(t *testing.T) {
+	actual := DefaultRules.Parse("http://walrus.com/?u=https://shop.example.com/")
+	assert.Equal(t, "", actual.Wrapper)
+	assert.Equal(t, "", actual.UnwrappedURL)
+}
+
+func TestCustomRedirectRule(t *testing.T) {
+	rules := RuleSet{
+		RedirectRules: map[string]RedirectRule{
+			"go.zambo.com/out": {Label: "Zambo", Parameters: []string{"to"}},
+		},
+	}
+	actual := rules.Parse("https://go.zambo.com/out?to=https%3A%2F%2Fshop.example.com%2F")
+	assert.Equal(t, "Zambo", actual.Wrapper)
+	assert.Equal(t, "https://shop.example.com/", actual.UnwrappedURL)
+}
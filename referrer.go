@@ -9,6 +9,8 @@ const (
 	Email
 	Search
 	Social
+	Unknown
+	AI
 )
 
 func (r ReferrerType) String() string {
@@ -25,19 +27,71 @@ func (r ReferrerType) String() string {
 		return "search"
 	case Social:
 		return "social"
+	case Unknown:
+		return "unknown"
+	case AI:
+		return "ai"
+	}
+}
+
+// HostKind tells domain names apart from hosts without a public suffix,
+// which are classified as Indirect with the host kept in Domain, and from
+// apps, which are classified by AppRules.
+type HostKind int
+
+const (
+	DomainHost HostKind = iota
+	IPv4Host
+	IPv6Host
+	LoopbackHost
+	PrivateHost
+	SingleLabelHost
+	AppHost
+)
+
+func (h HostKind) String() string {
+	switch h {
+	default:
+		return "domain"
+	case IPv4Host:
+		return "ipv4"
+	case IPv6Host:
+		return "ipv6"
+	case LoopbackHost:
+		return "loopback"
+	case PrivateHost:
+		return "private"
+	case SingleLabelHost:
+		return "single label"
+	case AppHost:
+		return "app"
 	}
 }
 
 type Referrer struct {
-	Type       ReferrerType
-	Label      string
-	URL        string
-	Subdomain  string
-	Domain     string
-	Tld        string
-	Path       string
-	Query      string
-	GoogleType GoogleSearchType
+	Type         ReferrerType
+	Label        string
+	URL          string
+	Subdomain    string
+	Domain       string
+	Tld          string
+	Port         string
+	HostKind     HostKind
+	Path         string
+	Query        string
+	QueryStatus  QueryStatus
+	QueryCharset string
+	Paid         bool
+	GoogleType   GoogleSearchType
+	Vertical     SearchVertical
+	Search       *SearchDetails
+	Campaign     Campaign
+	ClickIDs     []ClickID
+	Wrapper      string
+	UnwrappedURL string
+	App          string
+	AppID        string
+	Match        Match
 }
 
 func (r *Referrer) RegisteredDomain() string {
@@ -49,6 +103,9 @@ func (r *Referrer) RegisteredDomain() string {
 }
 
 func (r *Referrer) Host() string {
+	if r.Tld == "" {
+		return r.Domain
+	}
 	if r.Subdomain != "" {
 		return r.Subdomain + "." + r.RegisteredDomain()
 	}
@@ -56,6 +113,46 @@ func (r *Referrer) Host() string {
 	return r.RegisteredDomain()
 }
 
+// ASCIIHost returns the host in its IDNA ASCII form, e.g.
+// "xn--80asehdb.xn--p1ai".
+func (r *Referrer) ASCIIHost() string {
+	return asciiHost(r.Host())
+}
+
+// UnicodeHost returns the host in its Unicode form, e.g. "онлайн.рф".
+func (r *Referrer) UnicodeHost() string {
+	return unicodeHost(r.Host())
+}
+
+// QueryStatus tells why a Search or AI referrer has or lacks a Query.
+// Engines like Google only send their origin over https, so the query is
+// NotProvided, which reports show as "(not provided)". It is
+// QueryNotApplicable for other referrers.
+type QueryStatus int
+
+const (
+	QueryNotApplicable QueryStatus = iota
+	QueryPresent
+	QueryNotProvided
+	QueryEmpty
+	QueryUnsupported
+)
+
+func (q QueryStatus) String() string {
+	switch q {
+	default:
+		return "not applicable"
+	case QueryPresent:
+		return "present"
+	case QueryNotProvided:
+		return "not provided"
+	case QueryEmpty:
+		return "empty"
+	case QueryUnsupported:
+		return "unsupported"
+	}
+}
+
 type GoogleSearchType int
 
 const (
@@ -74,3 +171,42 @@ func (g GoogleSearchType) String() string {
 		return "google adwords referrer"
 	}
 }
+
+// SearchVertical tells which part of a search engine a Search referrer came
+// from. It is NotSearch for other referrers.
+type SearchVertical int
+
+const (
+	NotSearch SearchVertical = iota
+	WebSearch
+	ImageSearch
+	VideoSearch
+	NewsSearch
+	MapsSearch
+	ShoppingSearch
+	ScholarSearch
+	BookSearch
+)
+
+func (s SearchVertical) String() string {
+	switch s {
+	default:
+		return "not search"
+	case WebSearch:
+		return "web"
+	case ImageSearch:
+		return "image"
+	case VideoSearch:
+		return "video"
+	case NewsSearch:
+		return "news"
+	case MapsSearch:
+		return "maps"
+	case ShoppingSearch:
+		return "shopping"
+	case ScholarSearch:
+		return "scholar"
+	case BookSearch:
+		return "books"
+	}
+}
@@ -1,51 +1,281 @@
 package goreferrer
 
 import (
+	"errors"
+	"fmt"
+	"net"
 	"net/url"
 	"strings"
 
+	"golang.org/x/net/idna"
 	"golang.org/x/net/publicsuffix"
 )
 
+var (
+	ErrUnparseableURL    = errors.New("unparseable url")
+	ErrMissingHost       = errors.New("missing host")
+	ErrNoPublicSuffix    = errors.New("host not under a public suffix")
+	ErrUnroutableIP      = errors.New("unspecified or multicast ip host")
+	ErrBareSuffix        = errors.New("host is a bare public suffix")
+	ErrControlCharacters = errors.New("control characters")
+)
+
+// ParseError explains why a referrer is Invalid. Err is one of the Err
+// values above, so reasons can be told apart with errors.Is.
+type ParseError struct {
+	URL string
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("goreferrer: invalid referrer %q: %v", e.URL, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
 type richUrl struct {
 	*url.URL
 	Subdomain string
 	Domain    string
 	Tld       string
+	Port      string
+	HostKind  HostKind
+	AppID     string
+	// ICANN is set when Tld is an ICANN suffix rather than a private one
+	// like blogspot.com, only those are matched by "domain.*" rules.
+	ICANN      bool
+	query      url.Values
+	candidates [2][]hostCandidate
+	prefixes   []string
+}
+
+// Query parses the query once, as matching reads it several times.
+func (u *richUrl) Query() url.Values {
+	if u.query == nil {
+		u.query = u.URL.Query()
+	}
+
+	return u.query
+}
+
+// ruleCandidates returns the host candidates and path prefixes findRule
+// probes, computed once as redirect, domain and vertical rules all use them.
+func (u *richUrl) ruleCandidates() ([2][]hostCandidate, []string) {
+	if u.prefixes == nil {
+		u.candidates, u.prefixes = hostCandidates(u), pathPrefixes(u.Path)
+	}
+
+	return u.candidates, u.prefixes
 }
 
 func parseRichUrl(s string) (*richUrl, bool) {
-	u, err := url.Parse(s)
-	if err != nil {
-		return nil, false
+	u, err := parseRichUrlE(s)
+	return u, err == nil
+}
+
+func parseRichUrlE(s string) (*richUrl, error) {
+	if strings.IndexFunc(s, isControl) != -1 {
+		return nil, ErrControlCharacters
 	}
 
-	// assume a default scheme of http://
-	if u.Scheme == "" {
-		s = "http://" + s
+	// assume a default scheme of http://, which is certain without a colon
+	// and saves parsing the url twice.
+	var u *url.URL
+	var err error
+	if strings.Contains(s, ":") {
 		u, err = url.Parse(s)
 		if err != nil {
-			return nil, false
+			return nil, ErrUnparseableURL
 		}
 	}
+	explicitScheme := u != nil && u.Scheme != ""
+	if !explicitScheme {
+		u, err = url.Parse("http://" + s)
+		if err != nil {
+			return nil, ErrUnparseableURL
+		}
+	}
+
+	// android-app://com.google.android.gm/ and ios-app://422689480/ name the
+	// app in place of the host.
+	if u.Scheme == "android-app" || u.Scheme == "ios-app" {
+		if u.Host == "" {
+			return nil, ErrMissingHost
+		}
+		return appUrl(u, strings.ToLower(u.Host)), nil
+	}
+
+	// Rules and domains are matched against the lowercase IDNA ASCII host
+	// without port, userinfo or trailing dot. The zone of an IPv6 literal like
+	// [fe80::1%25en0] only means something on the sending machine.
+	hostname := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if strings.Contains(hostname, ":") {
+		hostname, _, _ = strings.Cut(hostname, "%")
+	}
+	host := asciiHost(hostname)
+	if host == "" {
+		return nil, ErrMissingHost
+	}
+	rich := &richUrl{URL: u, Port: u.Port()}
+	if rich.Port == defaultPorts[u.Scheme] {
+		rich.Port = ""
+	}
+	u.Host, u.User = host, nil
 
-	tld, _ := publicsuffix.PublicSuffix(u.Host)
-	if tld == "" || len(u.Host)-len(tld) < 2 {
-		return nil, false
+	if ip := net.ParseIP(host); ip != nil {
+		rich.Domain, rich.HostKind = host, ipHostKind(ip)
+		if rich.HostKind == DomainHost {
+			return nil, ErrUnroutableIP
+		}
+		return rich, nil
 	}
 
-	hostWithoutTld := u.Host[:len(u.Host)-len(tld)-1]
-	lastDot := strings.LastIndex(hostWithoutTld, ".")
-	if lastDot == -1 {
-		return &richUrl{URL: u, Domain: hostWithoutTld, Tld: tld}, true
+	tld, icann := publicsuffix.PublicSuffix(host)
+	if tld == "" || len(host)-len(tld) < 2 {
+		if icann || strings.Contains(tld, ".") || tld != host {
+			return nil, ErrBareSuffix
+		}
+
+		// Browsers always send at least "/" as the path of a referrer, so a
+		// bare word without one is more likely garbage than an intranet host.
+		rich.Domain = host
+		switch {
+		case host == "localhost":
+			rich.HostKind = LoopbackHost
+		case explicitScheme && !containsString(networkSchemes, u.Scheme):
+			// Custom schemes like fb://profile/4 are named after their app.
+			return appUrl(u, u.Scheme), nil
+		case explicitScheme && u.Path != "":
+			rich.HostKind = SingleLabelHost
+		default:
+			return nil, ErrNoPublicSuffix
+		}
+		return rich, nil
+	}
+
+	rich.Tld, rich.ICANN = tld, icann
+	rich.Domain = host[:len(host)-len(tld)-1]
+	if lastDot := strings.LastIndex(rich.Domain, "."); lastDot != -1 {
+		rich.Subdomain, rich.Domain = rich.Domain[:lastDot], rich.Domain[lastDot+1:]
+	}
+
+	return rich, nil
+}
+
+func appUrl(u *url.URL, appID string) *richUrl {
+	return &richUrl{URL: u, HostKind: AppHost, AppID: appID}
+}
+
+var defaultPorts = map[string]string{"http": "80", "https": "443"}
+
+// networkSchemes name protocols rather than apps, their single label hosts
+// are intranet hosts like under http.
+var networkSchemes = []string{"http", "https", "ftp", "ftps", "sftp", "ssh", "ws", "wss", "file", "smb", "nfs", "git", "svn", "rtsp", "telnet", "gopher"}
+
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// ipHostKind returns DomainHost for addresses that can't be a referrer, like
+// unspecified and multicast ones.
+func ipHostKind(ip net.IP) HostKind {
+	switch {
+	case ip.IsUnspecified() || ip.IsMulticast():
+		return DomainHost
+	case ip.IsLoopback():
+		return LoopbackHost
+	case ip.IsPrivate() || ip.IsLinkLocalUnicast() || sharedAddressSpace.Contains(ip):
+		return PrivateHost
+	case ip.To4() != nil:
+		return IPv4Host
+	}
+
+	return IPv6Host
+}
+
+// asciiHost maps a host to its IDNA ASCII form following UTS #46. Hosts IDNA
+// rejects, like ones with underscores, are kept as they are.
+func asciiHost(host string) string {
+	if isASCII(host) {
+		return host
+	}
+	if ascii, err := idna.Lookup.ToASCII(host); err == nil {
+		return ascii
+	}
+
+	return host
+}
+
+// unicodeHost maps punycode labels of a host back to Unicode for display.
+func unicodeHost(host string) string {
+	if !strings.Contains(host, "xn--") {
+		return host
+	}
+	if unicode, err := idna.Display.ToUnicode(host); err == nil {
+		return unicode
+	}
+
+	return host
+}
+
+// asciiRuleKey maps the host of a rule key to its IDNA ASCII form, keeping
+// wildcards and the path.
+func asciiRuleKey(key string) string {
+	if isASCII(key) {
+		return key
+	}
+
+	return mapRuleKeyHost(key, asciiHost)
+}
+
+// unicodeRuleKey maps punycode labels of a rule key back to Unicode, which is
+// how rules written by hand often name international hosts.
+func unicodeRuleKey(key string) string {
+	if !strings.Contains(key, "xn--") {
+		return key
+	}
+
+	return mapRuleKeyHost(key, unicodeHost)
+}
+
+func mapRuleKeyHost(key string, mapHost func(string) string) string {
+	host, rulePath := splitRuleKey(key)
+	prefix, suffix := "", ""
+	if strings.HasPrefix(host, "*.") {
+		prefix, host = "*.", host[2:]
+	}
+	if strings.HasSuffix(host, ".*") {
+		suffix, host = ".*", host[:len(host)-2]
+	}
+
+	return prefix + mapHost(host) + suffix + rulePath
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isControl(r rune) bool {
+	return r < 0x20 || r == 0x7f
+}
+
+// matchesDomain compares the host with a domain, which may be in any case
+// and only has to match the port when it has one.
+func (u *richUrl) matchesDomain(domain string) bool {
+	host, port := domain, ""
+	// A bare IPv6 address like "::1" has colons but no port.
+	if i := strings.LastIndexByte(domain, ':'); i != -1 && !strings.Contains(domain[i:], "]") && net.ParseIP(domain) == nil {
+		host, port = domain[:i], domain[i+1:]
 	}
+	host = asciiHost(strings.TrimSuffix(strings.ToLower(strings.Trim(host, "[]")), "."))
 
-	return &richUrl{
-		URL:       u,
-		Subdomain: hostWithoutTld[:lastDot],
-		Domain:    hostWithoutTld[lastDot+1:],
-		Tld:       tld,
-	}, true
+	return host == u.Host && (port == "" || port == u.Port || (u.Port == "" && port == defaultPorts[u.Scheme]))
 }
 
 func (u *richUrl) RegisteredDomain() string {
@@ -5,20 +5,37 @@ import (
 	"io"
 	"net/url"
 	"path"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
+// DomainRule classifies urls matching its domain. Paid marks sources that
+// are always paid, such as the ad networks in the "paid" category. Priority
+// decides between labels of the same category listing the same domain.
 type DomainRule struct {
-	Type       ReferrerType
-	Label      string
-	Domain     string
-	Parameters []string
+	Type           ReferrerType
+	Label          string
+	Domain         string
+	Parameters     []string
+	Paid           bool
+	PaidPaths      []string
+	PaidParameters []string
+	Priority       int
 }
 
+// UaRule matches user agents containing its key, or matching Pattern when
+// set. Rules are tried by descending Priority, ties broken by key.
 type UaRule struct {
-	Url    string
-	Domain string
-	Tld    string
+	Url      string
+	Domain   string
+	Tld      string
+	App      string
+	Priority int
+	Pattern  *regexp.Regexp
 }
 
 func (u UaRule) RegisteredDomain() string {
@@ -29,18 +46,37 @@ func (u UaRule) RegisteredDomain() string {
 	return u.Domain + "." + u.Tld
 }
 
+// AppRule classifies referrers from native apps, keyed by the lowercase
+// Android package name, iOS bundle or App Store ID, or custom url scheme.
+// Domain and Tld stand in for the missing host, like they do for a UaRule.
+type AppRule struct {
+	Type   ReferrerType
+	Label  string
+	Domain string
+	Tld    string
+}
+
 type RuleSet struct {
-	DomainRules map[string]DomainRule
-	UaRules     map[string]UaRule
+	DomainRules   map[string]DomainRule
+	UaRules       map[string]UaRule
+	RedirectRules map[string]RedirectRule
+	AppRules      map[string]AppRule
+	VerticalRules map[string]VerticalRule
 }
 
 func NewRuleSet() RuleSet {
 	return RuleSet{
-		DomainRules: make(map[string]DomainRule),
-		UaRules:     make(map[string]UaRule),
+		DomainRules:   make(map[string]DomainRule),
+		UaRules:       make(map[string]UaRule),
+		RedirectRules: make(map[string]RedirectRule),
+		AppRules:      make(map[string]AppRule),
+		VerticalRules: make(map[string]VerticalRule),
 	}
 }
 
+// Merge adds the rules of other to r in place. Merging into a rule set that
+// is being used to parse concurrently is a data race, use a RuleSetBuilder
+// and an AtomicClassifier instead.
 func (r RuleSet) Merge(other RuleSet) {
 	for k, v := range other.DomainRules {
 		r.DomainRules[k] = v
@@ -48,6 +84,15 @@ func (r RuleSet) Merge(other RuleSet) {
 	for k, v := range other.UaRules {
 		r.UaRules[k] = v
 	}
+	for k, v := range other.RedirectRules {
+		r.RedirectRules[k] = v
+	}
+	for k, v := range other.AppRules {
+		r.AppRules[k] = v
+	}
+	for k, v := range other.VerticalRules {
+		r.VerticalRules[k] = v
+	}
 }
 
 func (r RuleSet) Parse(URL string) Referrer {
@@ -55,85 +100,310 @@ func (r RuleSet) Parse(URL string) Referrer {
 }
 
 func (r RuleSet) ParseWith(URL string, domains []string, agent string) Referrer {
+	ref, _ := parseWith(r, URL, domains, agent)
+	return ref
+}
+
+// ParseE is Parse also returning a *ParseError when the referrer is Invalid.
+func (r RuleSet) ParseE(URL string) (Referrer, error) {
+	return r.ParseWithE(URL, nil, "")
+}
+
+func (r RuleSet) ParseWithE(URL string, domains []string, agent string) (Referrer, error) {
+	return parseWith(r, URL, domains, agent)
+}
+
+// ruleMatcher looks up rules for a url or user agent. It is implemented by
+// RuleSet, which probes its maps directly, and by the compiled Classifier.
+type ruleMatcher interface {
+	getDomainRule(u *richUrl) (DomainRule, ruleKey, bool)
+	getRedirectRule(u *richUrl) (RedirectRule, bool)
+	getUaRule(agent string) (string, UaRule)
+	getAppRule(appID string) (AppRule, bool)
+	getVerticalRule(u *richUrl) (VerticalRule, bool)
+}
+
+func parseWith(m ruleMatcher, URL string, domains []string, agent string) (Referrer, error) {
+	uaKey, uaRule := m.getUaRule(agent)
 	ref := Referrer{
 		Type: Indirect,
 		URL:  strings.Trim(URL, " \t\r\n"),
+		App:  uaRule.App,
 	}
 
-	uaRule := r.getUaRule(agent)
-	if ref.URL == "" {
+	kind := DomainRuleMatch
+	if ref.URL == "" && uaRule.Url != "" {
 		ref.URL = uaRule.Url
+		ref.Match.UaRule = uaKey
+		kind = UaRuleMatch
 	}
 	if ref.URL == "" {
 		ref.Type = Direct
-		return ref
+		return ref, nil
 	}
 
-	u, ok := parseRichUrl(ref.URL)
-	if !ok {
+	u, err := parseRichUrlE(ref.URL)
+	if err != nil {
 		ref.Type = Invalid
-		return ref
+		return ref, &ParseError{URL: ref.URL, Err: err}
 	}
 
-	ref.Subdomain = u.Subdomain
-	ref.Domain = u.Domain
-	ref.Tld = u.Tld
+	ref.Subdomain = unicodeHost(u.Subdomain)
+	ref.Domain = unicodeHost(u.Domain)
+	ref.Tld = unicodeHost(u.Tld)
+	ref.Port = u.Port
+	ref.HostKind = u.HostKind
 	ref.Path = cleanPath(u.Path)
 
 	if ref.Domain == "" {
 		ref.Domain = uaRule.Domain
 	}
-	if ref.Tld == "" {
+	if ref.Tld == "" && u.HostKind == DomainHost {
 		ref.Tld = uaRule.Tld
 	}
 
+	if u.HostKind == AppHost {
+		ref.AppID = u.AppID
+		if appRule, exists := m.getAppRule(u.AppID); exists {
+			ref.Type = appRule.Type
+			ref.Label = appRule.Label
+			ref.Domain = appRule.Domain
+			ref.Tld = appRule.Tld
+			ref.Match.Kind = AppRuleMatch
+			ref.Match.Rule = u.AppID
+			return ref, nil
+		}
+
+		ref.Label = u.AppID
+		ref.Match.Kind = FallbackMatch
+		return ref, nil
+	}
+
 	for _, domain := range domains {
-		if u.Host == domain {
+		if u.matchesDomain(domain) {
 			ref.Type = Direct
-			return ref
+			ref.Match.Kind = DirectDomainMatch
+			ref.Match.Rule = domain
+			return ref, nil
 		}
 	}
 
-	variations := []string{
-		path.Join(u.Host, u.Path),
-		path.Join(u.RegisteredDomain(), u.Path),
-		u.Host,
-		u.RegisteredDomain(),
+	if u.HostKind != DomainHost {
+		ref.Label = u.Domain
+		ref.Match.Kind = FallbackMatch
+		return ref, nil
 	}
 
-	for _, host := range variations {
-		domainRule, exists := r.DomainRules[host]
-		if !exists {
-			continue
-		}
+	redirectParam := unwrapRedirects(m, u, &ref)
 
-		query := getQuery(u.Query(), domainRule.Parameters)
+	if domainRule, key, exists := m.getDomainRule(u); exists {
+		values := u.Query()
+		if redirectParam != "" {
+			values = withoutParam(values, redirectParam)
+		}
+		fragment, _ := url.ParseQuery(u.Fragment)
+		query := getQuery(values, domainRule.Parameters)
 		if query == "" {
-			values, err := url.ParseQuery(u.Fragment)
-			if err == nil {
-				query = getQuery(values, domainRule.Parameters)
-			}
+			query = getQuery(fragment, domainRule.Parameters)
 		}
 
 		ref.Type = domainRule.Type
 		ref.Label = domainRule.Label
 		ref.Query = query
+		ref.Paid = isPaid(u, domainRule)
 		ref.GoogleType = googleSearchType(ref)
-		return ref
+		if ref.Type == Search || ref.Type == AI {
+			ref.QueryStatus = queryStatus(domainRule, u, query, values, fragment)
+			ref.QueryCharset = queryCharset(values)
+		}
+		if ref.Type == Search {
+			ref.Vertical = searchVertical(m, u)
+			ref.Search = searchDetails(u)
+		}
+		ref.Match.Kind = kind
+		ref.Match.Rule = key.Key
+		ref.Match.Variation = key.Variation
+		return ref, nil
 	}
 
-	ref.Label = strings.Title(u.Domain)
-	return ref
+	ref.Label = strings.Title(ref.Domain)
+	ref.Match.Kind = FallbackMatch
+	return ref, nil
+}
+
+type ruleKey struct {
+	Key       string
+	Variation MatchVariation
+}
+
+type hostCandidate struct {
+	Host      string
+	Variation MatchVariation
+}
+
+func (r RuleSet) getDomainRule(u *richUrl) (DomainRule, ruleKey, bool) {
+	return findRule(r.DomainRules, u)
+}
+
+func (r RuleSet) getAppRule(appID string) (AppRule, bool) {
+	rule, exists := r.AppRules[appID]
+	return rule, exists
+}
+
+// findRule looks up the rule for a url. Hosts and their subdomain wildcards
+// such as "*.search.yahoo.com" take precedence over public suffix wildcards
+// such as "www.google.*", which only match ICANN suffixes. Within each group
+// the longest matching path prefix wins, then the most specific host: the
+// host itself, then its subdomain wildcards from the deepest up, then its
+// registered domain. A subdomain wildcard never matches the bare domain
+// itself.
+func findRule[T any](rules map[string]T, u *richUrl) (T, ruleKey, bool) {
+	if len(rules) > 0 {
+		var buf [128]byte
+		groups, prefixes := u.ruleCandidates()
+		for _, group := range groups {
+			for _, prefix := range prefixes {
+				for _, candidate := range group {
+					key := append(append(buf[:0], candidate.Host...), prefix...)
+					if rule, exists := rules[string(key)]; exists {
+						variation := candidate.Variation
+						if prefix != "" {
+							variation = pathVariation(variation)
+						}
+						return rule, ruleKey{string(key), variation}, true
+					}
+				}
+			}
+		}
+	}
+
+	var none T
+	return none, ruleKey{}, false
+}
+
+// hostCandidates returns the keys a host can match in two groups: the host
+// itself, its subdomain wildcards from the deepest parent up and its
+// registered domain, then its public suffix wildcards.
+func hostCandidates(u *richUrl) [2][]hostCandidate {
+	host, registered := u.Host, u.RegisteredDomain()
+	hosts := make([]hostCandidate, 1, strings.Count(host, ".")+1)
+	hosts[0] = hostCandidate{host, HostVariation}
+	for parent := host; parent != registered; {
+		i := strings.Index(parent, ".")
+		if i == -1 {
+			break
+		}
+		parent = parent[i+1:]
+		hosts = append(hosts, hostCandidate{"*." + parent, SubdomainWildcardVariation})
+	}
+	if registered != host {
+		hosts = append(hosts, hostCandidate{registered, RegisteredDomainVariation})
+	}
+
+	var suffixes []hostCandidate
+	if u.ICANN {
+		suffixes = []hostCandidate{{u.Domain + ".*", PublicSuffixWildcardVariation}}
+		if u.Subdomain != "" {
+			suffixes = append([]hostCandidate{{u.Subdomain + "." + u.Domain + ".*", PublicSuffixWildcardVariation}}, suffixes...)
+		}
+	}
+
+	if strings.Contains(host, "xn--") {
+		hosts, suffixes = withUnicodeKeys(hosts), withUnicodeKeys(suffixes)
+	}
+
+	return [2][]hostCandidate{hosts, suffixes}
+}
+
+// withUnicodeKeys follows each candidate of an international host with its
+// Unicode form, so rules keyed like "президент.рф" match without the key
+// being converted to punycode first.
+func withUnicodeKeys(candidates []hostCandidate) []hostCandidate {
+	var keys []hostCandidate
+	for _, candidate := range candidates {
+		keys = append(keys, candidate)
+		if key := unicodeRuleKey(candidate.Host); key != candidate.Host {
+			keys = append(keys, hostCandidate{key, candidate.Variation})
+		}
+	}
+
+	return keys
+}
+
+// pathPrefixes returns the cleaned path followed by each of its parents,
+// ending with the empty path which matches rules without one.
+func pathPrefixes(p string) []string {
+	var prefixes []string
+	for p = path.Clean("/" + p); p != "/"; p = path.Dir(p) {
+		prefixes = append(prefixes, p)
+	}
+
+	return append(prefixes, "")
+}
+
+func pathVariation(v MatchVariation) MatchVariation {
+	switch v {
+	case HostVariation:
+		return HostPathVariation
+	case RegisteredDomainVariation:
+		return RegisteredDomainPathVariation
+	}
+
+	return v
+}
+
+func (r RuleSet) getUaRule(agent string) (string, UaRule) {
+	if agent == "" {
+		return "", UaRule{}
+	}
+
+	// A single pass keeps the first rule in priority order that matches,
+	// without sorting the keys on every parse.
+	var bestKey string
+	var best UaRule
+	found := false
+	for key, rule := range r.UaRules {
+		if found && !uaRuleBefore(key, rule, bestKey, best) {
+			continue
+		}
+		if rule.matches(key, agent) {
+			bestKey, best, found = key, rule, true
+		}
+	}
+
+	return bestKey, best
 }
 
-func (r *RuleSet) getUaRule(agent string) UaRule {
-	for pattern, rule := range r.UaRules {
-		if strings.Contains(agent, pattern) {
-			return rule
+func (u UaRule) matches(key, agent string) bool {
+	if u.Pattern != nil {
+		literals := patternLiterals(u.Pattern)
+		if literals != nil && !containsAny(agent, literals) {
+			return false
 		}
+		return u.Pattern.MatchString(agent)
 	}
 
-	return UaRule{}
+	return strings.Contains(agent, key)
+}
+
+func uaRuleBefore(key string, rule UaRule, otherKey string, other UaRule) bool {
+	if rule.Priority != other.Priority {
+		return rule.Priority > other.Priority
+	}
+	return key < otherKey
+}
+
+func sortedUaRuleKeys(rules map[string]UaRule) []string {
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return uaRuleBefore(keys[i], rules[keys[i]], keys[j], rules[keys[j]])
+	})
+
+	return keys
 }
 
 func getQuery(values url.Values, params []string) string {
@@ -147,12 +417,78 @@ func getQuery(values url.Values, params []string) string {
 	return ""
 }
 
+// withoutParam returns a copy of values without param, leaving the parsed
+// query of the url untouched.
+func withoutParam(values url.Values, param string) url.Values {
+	copied := make(url.Values, len(values))
+	for key, value := range values {
+		if key != param {
+			copied[key] = value
+		}
+	}
+
+	return copied
+}
+
+// queryStatus tells a query that was stripped from the referrer apart from one
+// that was sent empty, and from rules that don't know where the query is.
+// Google's /url result redirects, marked esrc=s, keep an empty q when the
+// query was withheld.
+func queryStatus(rule DomainRule, u *richUrl, query string, values, fragment url.Values) QueryStatus {
+	switch {
+	case query != "":
+		return QueryPresent
+	case len(rule.Parameters) == 0:
+		return QueryUnsupported
+	case strings.Contains(rule.Label, "Google") && (u.Path == "/url" || values.Get("esrc") == "s"):
+		return QueryNotProvided
+	}
+
+	for _, param := range rule.Parameters {
+		if values.Has(param) || fragment.Has(param) {
+			return QueryEmpty
+		}
+	}
+
+	return QueryNotProvided
+}
+
+// isPaid reports whether the url matches one of the rule's paid patterns.
+// Paid paths starting with a slash are matched against the path alone,
+// others against the host and path, e.g. "r.search.yahoo.com/cbclk".
+func isPaid(u *richUrl, rule DomainRule) bool {
+	if rule.Paid {
+		return true
+	}
+
+	for _, paidPath := range rule.PaidPaths {
+		target := u.Path
+		if !strings.HasPrefix(paidPath, "/") {
+			target = u.Host + u.Path
+		}
+		if strings.HasPrefix(target, paidPath) {
+			return true
+		}
+	}
+
+	if len(rule.PaidParameters) > 0 {
+		values := u.Query()
+		for _, param := range rule.PaidParameters {
+			if values.Get(param) != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func googleSearchType(ref Referrer) GoogleSearchType {
 	if ref.Type != Search || !strings.Contains(ref.Label, "Google") {
 		return NotGoogleSearch
 	}
 
-	if strings.HasPrefix(ref.Path, "/aclk") || strings.HasPrefix(ref.Path, "/pagead/aclk") {
+	if ref.Paid {
 		return Adwords
 	}
 
@@ -167,14 +503,22 @@ func cleanPath(path string) string {
 }
 
 type jsonRule struct {
-	Domains    []string
-	Parameters []string
+	Domains        []string `json:"domains" yaml:"domains"`
+	Parameters     []string `json:"parameters,omitempty" yaml:"parameters"`
+	PaidPaths      []string `json:"paid_paths,omitempty" yaml:"paid_paths"`
+	PaidParameters []string `json:"paid_parameters,omitempty" yaml:"paid_parameters"`
+	Priority       int      `json:"priority,omitempty" yaml:"priority"`
 }
 
+// jsonRules follows the Snowplow referer-parser database layout, a map of
+// labels for each medium.
 type jsonRules struct {
-	Email  map[string]jsonRule
-	Search map[string]jsonRule
-	Social map[string]jsonRule
+	AI      map[string]jsonRule `json:"ai,omitempty" yaml:"ai"`
+	Email   map[string]jsonRule `json:"email,omitempty" yaml:"email"`
+	Paid    map[string]jsonRule `json:"paid,omitempty" yaml:"paid"`
+	Search  map[string]jsonRule `json:"search,omitempty" yaml:"search"`
+	Social  map[string]jsonRule `json:"social,omitempty" yaml:"social"`
+	Unknown map[string]jsonRule `json:"unknown,omitempty" yaml:"unknown"`
 }
 
 func LoadJsonDomainRules(reader io.Reader) (map[string]DomainRule, error) {
@@ -183,24 +527,140 @@ func LoadJsonDomainRules(reader io.Reader) (map[string]DomainRule, error) {
 		return nil, err
 	}
 
-	rules := NewRuleSet()
-	rules.Merge(extractRules(decoded.Email, Email))
-	rules.Merge(extractRules(decoded.Search, Search))
-	rules.Merge(extractRules(decoded.Social, Social))
-	return rules.DomainRules, nil
+	return decoded.domainRules(), nil
+}
+
+// LoadYamlDomainRules reads rules in the format of the Snowplow
+// referer-parser referers.yml database.
+func LoadYamlDomainRules(reader io.Reader) (map[string]DomainRule, error) {
+	var decoded jsonRules
+	if err := yaml.NewDecoder(reader).Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	return decoded.domainRules(), nil
 }
 
-func extractRules(ruleMap map[string]jsonRule, Type ReferrerType) RuleSet {
-	rules := NewRuleSet()
+// domainRules resolves domains listed more than once by rulePrecedence, so
+// the result doesn't depend on map iteration order.
+func (decoded jsonRules) domainRules() map[string]DomainRule {
+	rules := make(map[string]DomainRule)
+	extractRules(rules, decoded.Unknown, Unknown, false)
+	extractRules(rules, decoded.Paid, Unknown, true)
+	extractRules(rules, decoded.Email, Email, false)
+	extractRules(rules, decoded.Search, Search, false)
+	extractRules(rules, decoded.AI, AI, false)
+	extractRules(rules, decoded.Social, Social, false)
+	return rules
+}
+
+func extractRules(rules map[string]DomainRule, ruleMap map[string]jsonRule, Type ReferrerType, paid bool) {
 	for label, jsonRule := range ruleMap {
 		for _, domain := range jsonRule.Domains {
-			rules.DomainRules[domain] = DomainRule{
-				Type:       Type,
-				Label:      label,
-				Domain:     domain,
-				Parameters: jsonRule.Parameters,
+			domain = asciiRuleKey(domain)
+			rule := DomainRule{
+				Type:           Type,
+				Label:          label,
+				Domain:         domain,
+				Parameters:     jsonRule.Parameters,
+				Paid:           paid,
+				PaidPaths:      jsonRule.PaidPaths,
+				PaidParameters: jsonRule.PaidParameters,
+				Priority:       jsonRule.Priority,
+			}
+			if current, exists := rules[domain]; !exists || rulePrecedence(rule).outranks(rulePrecedence(current)) {
+				rules[domain] = rule
 			}
 		}
 	}
-	return rules
+}
+
+// categoryPrecedence lists the categories from the lowest to the highest
+// precedence.
+var categoryPrecedence = []string{"unknown", "paid", "email", "search", "ai", "social"}
+
+type precedence struct {
+	Category string
+	Priority int
+	Label    string
+}
+
+func rulePrecedence(rule DomainRule) precedence {
+	return precedence{Category: ruleCategory(rule), Priority: rule.Priority, Label: rule.Label}
+}
+
+// outranks orders listings of the same domain by category, then by explicit
+// priority, then by label, the lexically smallest label winning.
+func (p precedence) outranks(other precedence) bool {
+	a, b := slices.Index(categoryPrecedence, p.Category), slices.Index(categoryPrecedence, other.Category)
+	switch {
+	case a != b:
+		return a > b
+	case p.Priority != other.Priority:
+		return p.Priority > other.Priority
+	}
+
+	return p.Label < other.Label
+}
+
+// WriteJsonDomainRules writes rules in the format read by
+// LoadJsonDomainRules. Domains sharing a medium and label are grouped under
+// one label, with the union of their parameters and paid patterns.
+func WriteJsonDomainRules(writer io.Writer, rules map[string]DomainRule) error {
+	var encoded jsonRules
+	domains := make([]string, 0, len(rules))
+	for domain := range rules {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	for _, domain := range domains {
+		rule := rules[domain]
+		var medium *map[string]jsonRule
+		switch {
+		case rule.Type == Email:
+			medium = &encoded.Email
+		case rule.Type == Search:
+			medium = &encoded.Search
+		case rule.Type == Social:
+			medium = &encoded.Social
+		case rule.Type == AI:
+			medium = &encoded.AI
+		case rule.Type == Unknown && rule.Paid:
+			medium = &encoded.Paid
+		case rule.Type == Unknown:
+			medium = &encoded.Unknown
+		default:
+			continue
+		}
+		if *medium == nil {
+			*medium = make(map[string]jsonRule)
+		}
+
+		group := (*medium)[rule.Label]
+		group.Domains = append(group.Domains, domain)
+		group.Priority = max(group.Priority, rule.Priority)
+		group.Parameters = appendMissing(group.Parameters, rule.Parameters)
+		group.PaidPaths = appendMissing(group.PaidPaths, rule.PaidPaths)
+		group.PaidParameters = appendMissing(group.PaidParameters, rule.PaidParameters)
+		(*medium)[rule.Label] = group
+	}
+
+	out, err := json.MarshalIndent(encoded, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(append(out, '\n'))
+	return err
+}
+
+func appendMissing(values []string, more []string) []string {
+	for _, value := range more {
+		if !containsString(values, value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
 }
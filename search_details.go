@@ -0,0 +1,160 @@
+package goreferrer
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SearchDetails is the context of a search results page, read from well
+// known parameters of the major engines. Fields are empty when the referrer
+// doesn't carry them.
+type SearchDetails struct {
+	// Page is the 1-based result page, assuming ten results per page. It is
+	// only set when the referrer has an offset parameter.
+	Page int
+	// Offset is the 0-based index of the first result on the page.
+	Offset int
+	// Language is the interface language, e.g. "en" or "de-ch".
+	Language string
+	// Country is an uppercase ISO 3166 code, taken from a parameter or the
+	// country code top level domain of the engine.
+	Country string
+	// Source is the engine's hint where the search was started, e.g. "hp"
+	// for the Google home page.
+	Source string
+	// FromResultsPage is set when the search was submitted from the
+	// engine's own results page, which Google marks with sxsrf.
+	FromResultsPage bool
+	// SafeSearch is the filtering level from Google's safe, Bing's adlt or
+	// Yandex and DuckDuckGo's kp.
+	SafeSearch SafeSearchLevel
+}
+
+// SafeSearchLevel is how strictly a search engine filtered explicit results.
+type SafeSearchLevel int
+
+const (
+	SafeSearchUnknown SafeSearchLevel = iota
+	SafeSearchOff
+	SafeSearchModerate
+	SafeSearchStrict
+)
+
+func (s SafeSearchLevel) String() string {
+	switch s {
+	default:
+		return "unknown"
+	case SafeSearchOff:
+		return "off"
+	case SafeSearchModerate:
+		return "moderate"
+	case SafeSearchStrict:
+		return "strict"
+	}
+}
+
+// safeSearchParameters maps the safe search parameter of each engine to the
+// levels its values stand for.
+var safeSearchParameters = []struct {
+	Name   string
+	Levels map[string]SafeSearchLevel
+}{
+	{"safe", map[string]SafeSearchLevel{
+		"off":      SafeSearchOff,
+		"images":   SafeSearchModerate,
+		"moderate": SafeSearchModerate,
+		"medium":   SafeSearchModerate,
+		"active":   SafeSearchStrict,
+		"strict":   SafeSearchStrict,
+		"on":       SafeSearchStrict,
+		"high":     SafeSearchStrict,
+	}},
+	{"adlt", map[string]SafeSearchLevel{"off": SafeSearchOff, "moderate": SafeSearchModerate, "strict": SafeSearchStrict}},
+	{"kp", map[string]SafeSearchLevel{"-2": SafeSearchOff, "-1": SafeSearchModerate, "1": SafeSearchStrict}},
+}
+
+const resultsPerPage = 10
+
+// offsetParameters maps result offset parameters to the index they start
+// counting from: Google's start is 0-based, Bing's first and Yahoo!'s b are
+// 1-based.
+var offsetParameters = []struct {
+	Name string
+	Base int
+}{
+	{"start", 0},
+	{"first", 1},
+	{"b", 1},
+}
+
+// countryDomains are country code top level domains that differ from the
+// ISO 3166 code of their country.
+var countryDomains = map[string]string{"uk": "GB"}
+
+// genericCountryDomains are country code top level domains mostly used
+// without regard to the country, like .io or .tv, and .eu which isn't one.
+// Second level domains under them, such as com.co, still count.
+var genericCountryDomains = []string{"ai", "cc", "co", "eu", "fm", "gg", "io", "ly", "me", "nu", "tk", "to", "tv", "ws"}
+
+// searchDetails returns nil when the referrer carries no details.
+func searchDetails(u *richUrl) *SearchDetails {
+	values := u.Query()
+	details := SearchDetails{
+		Language: strings.ToLower(getQuery(values, []string{"hl", "setlang"})),
+		Country:  strings.ToUpper(getQuery(values, []string{"gl", "cc"})),
+		Source:   values.Get("source"),
+	}
+	_, details.FromResultsPage = values["sxsrf"]
+	for _, param := range safeSearchParameters {
+		if level, ok := param.Levels[strings.ToLower(values.Get(param.Name))]; ok {
+			details.SafeSearch = level
+			break
+		}
+	}
+
+	for _, param := range offsetParameters {
+		if offset, ok := parseOffset(values, param.Name, param.Base); ok {
+			details.Offset = offset
+			details.Page = offset/resultsPerPage + 1
+			break
+		}
+	}
+	if details.Country == "" {
+		details.Country = tldCountry(u.Tld)
+	}
+
+	if details == (SearchDetails{}) {
+		return nil
+	}
+
+	return &details
+}
+
+func parseOffset(values url.Values, name string, base int) (int, bool) {
+	value := values.Get(name)
+	if value == "" {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < base {
+		return 0, false
+	}
+
+	return n - base, true
+}
+
+// tldCountry returns the country of a country code top level domain, such as
+// "CA" for "ca" or "GB" for "co.uk".
+func tldCountry(tld string) string {
+	label, _ := lastLabel(tld)
+	if country, ok := countryDomains[label]; ok {
+		return country
+	}
+	if len(label) != 2 || (label == tld && containsString(genericCountryDomains, label)) {
+		return ""
+	}
+
+	return strings.ToUpper(label)
+}
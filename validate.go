@@ -0,0 +1,346 @@
+package goreferrer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+	"gopkg.in/yaml.v3"
+)
+
+type DiagnosticKind int
+
+const (
+	InvalidDomain DiagnosticKind = iota
+	DuplicateDomain
+	ShadowedRule
+	NoPublicSuffix
+	MissingParameters
+	UnknownCategory
+)
+
+func (k DiagnosticKind) String() string {
+	switch k {
+	default:
+		return "invalid domain"
+	case DuplicateDomain:
+		return "duplicate domain"
+	case ShadowedRule:
+		return "shadowed rule"
+	case NoPublicSuffix:
+		return "no public suffix"
+	case MissingParameters:
+		return "missing parameters"
+	case UnknownCategory:
+		return "unknown category"
+	}
+}
+
+// RuleRef locates a rule by category, label and domain. Domain is empty for
+// diagnostics about a whole label, Label for ones about a whole category.
+type RuleRef struct {
+	Category string
+	Label    string
+	Domain   string
+}
+
+func (r RuleRef) String() string {
+	s := r.Category
+	if r.Label != "" {
+		s += " " + fmt.Sprintf("%q", r.Label)
+	}
+	if r.Domain != "" {
+		s += " " + r.Domain
+	}
+	return s
+}
+
+// Diagnostic describes a problem with a rule. Conflict is the listing a
+// duplicate domain loses to, or the rule that shadows a shadowed one.
+type Diagnostic struct {
+	Kind     DiagnosticKind
+	Rule     RuleRef
+	Conflict RuleRef
+}
+
+func (d Diagnostic) String() string {
+	switch d.Kind {
+	case DuplicateDomain:
+		return fmt.Sprintf("%s: %s loses to %s", d.Kind, d.Rule, d.Conflict)
+	case ShadowedRule:
+		return fmt.Sprintf("%s: %s is never matched where %s is", d.Kind, d.Rule, d.Conflict)
+	}
+
+	return fmt.Sprintf("%s: %s", d.Kind, d.Rule)
+}
+
+// ValidationError is returned by strict loading when the rules have any
+// diagnostics.
+type ValidationError struct {
+	Diagnostics []Diagnostic
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Diagnostics) == 1 {
+		return "goreferrer: " + e.Diagnostics[0].String()
+	}
+
+	return fmt.Sprintf("goreferrer: %s (and %d more problems)", e.Diagnostics[0], len(e.Diagnostics)-1)
+}
+
+var ruleCategories = []string{"ai", "email", "paid", "search", "social", "unknown"}
+
+type ruleListing struct {
+	Ref        RuleRef
+	Parameters []string
+	Priority   int
+}
+
+func (l ruleListing) precedence() precedence {
+	return precedence{Category: l.Ref.Category, Priority: l.Priority, Label: l.Ref.Label}
+}
+
+// ValidateJsonDomainRules lints rules in the format read by
+// LoadJsonDomainRules. The error is only set when the input can't be decoded.
+func ValidateJsonDomainRules(reader io.Reader) ([]Diagnostic, error) {
+	var raw map[string]map[string]jsonRule
+	if err := json.NewDecoder(reader).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	return validateCategories(raw), nil
+}
+
+func ValidateYamlDomainRules(reader io.Reader) ([]Diagnostic, error) {
+	var raw map[string]map[string]jsonRule
+	if err := yaml.NewDecoder(reader).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	return validateCategories(raw), nil
+}
+
+// ValidateDomainRules lints loaded rules. Duplicates and unknown categories
+// are lost once rules are loaded, use ValidateJsonDomainRules to find those.
+func ValidateDomainRules(rules map[string]DomainRule) []Diagnostic {
+	listings := make([]ruleListing, 0, len(rules))
+	for _, key := range sortedKeys(rules) {
+		rule := rules[key]
+		ref := RuleRef{Category: ruleCategory(rule), Label: rule.Label, Domain: key}
+		listings = append(listings, ruleListing{Ref: ref, Parameters: rule.Parameters})
+	}
+
+	return validateListings(listings)
+}
+
+// LoadJsonDomainRulesStrict is LoadJsonDomainRules failing with a
+// *ValidationError when the rules have any diagnostics.
+func LoadJsonDomainRulesStrict(reader io.Reader) (map[string]DomainRule, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics, err := ValidateJsonDomainRules(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if len(diagnostics) > 0 {
+		return nil, &ValidationError{Diagnostics: diagnostics}
+	}
+
+	return LoadJsonDomainRules(bytes.NewReader(data))
+}
+
+func LoadYamlDomainRulesStrict(reader io.Reader) (map[string]DomainRule, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics, err := ValidateYamlDomainRules(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if len(diagnostics) > 0 {
+		return nil, &ValidationError{Diagnostics: diagnostics}
+	}
+
+	return LoadYamlDomainRules(bytes.NewReader(data))
+}
+
+func validateCategories(raw map[string]map[string]jsonRule) []Diagnostic {
+	var diagnostics []Diagnostic
+	var listings []ruleListing
+	for _, category := range sortedKeys(raw) {
+		name := strings.ToLower(category)
+		if !containsString(ruleCategories, name) {
+			diagnostics = append(diagnostics, Diagnostic{Kind: UnknownCategory, Rule: RuleRef{Category: category}})
+			continue
+		}
+
+		labels := raw[category]
+		for _, label := range sortedKeys(labels) {
+			rule := labels[label]
+			for _, domain := range rule.Domains {
+				ref := RuleRef{Category: name, Label: label, Domain: domain}
+				listings = append(listings, ruleListing{Ref: ref, Parameters: rule.Parameters, Priority: rule.Priority})
+			}
+		}
+	}
+
+	winners := make(map[string]ruleListing)
+	for _, listing := range listings {
+		if winner, ok := winners[listing.Ref.Domain]; !ok || listing.precedence().outranks(winner.precedence()) {
+			winners[listing.Ref.Domain] = listing
+		}
+	}
+	for _, listing := range listings {
+		if winner := winners[listing.Ref.Domain]; winner.Ref != listing.Ref {
+			diagnostics = append(diagnostics, Diagnostic{Kind: DuplicateDomain, Rule: listing.Ref, Conflict: winner.Ref})
+		}
+	}
+
+	return append(diagnostics, validateListings(listings)...)
+}
+
+func validateListings(listings []ruleListing) []Diagnostic {
+	var diagnostics []Diagnostic
+	hosts, suffixes := hostListings(listings)
+	unparameterized := make(map[RuleRef]bool)
+	for _, listing := range listings {
+		ref := listing.Ref
+		if ref.Category == "search" && len(listing.Parameters) == 0 {
+			label := RuleRef{Category: ref.Category, Label: ref.Label}
+			if !unparameterized[label] {
+				unparameterized[label] = true
+				diagnostics = append(diagnostics, Diagnostic{Kind: MissingParameters, Rule: label})
+			}
+		}
+
+		host, rulePath := splitRuleKey(ref.Domain)
+		if !validRuleKey(host, rulePath) {
+			diagnostics = append(diagnostics, Diagnostic{Kind: InvalidDomain, Rule: ref})
+			continue
+		}
+		if strings.HasSuffix(host, ".*") {
+			if shadow, ok := shadowingRule(hosts, suffixes, strings.TrimSuffix(host, ".*"), rulePath); ok {
+				diagnostics = append(diagnostics, Diagnostic{Kind: ShadowedRule, Rule: ref, Conflict: shadow})
+			}
+			continue
+		}
+		host, _ = splitRuleKey(asciiRuleKey(ref.Domain))
+
+		bare := strings.TrimPrefix(host, "*.")
+		_, err := publicsuffix.EffectiveTLDPlusOne(bare)
+		if suffix, icann := publicsuffix.PublicSuffix(bare); err != nil || (!icann && !strings.Contains(suffix, ".")) {
+			diagnostics = append(diagnostics, Diagnostic{Kind: NoPublicSuffix, Rule: ref})
+		}
+	}
+
+	return diagnostics
+}
+
+// hostListings indexes the rules matched before public suffix wildcards by
+// key, along with the ICANN suffixes they are listed under.
+func hostListings(listings []ruleListing) (map[string]RuleRef, []string) {
+	hosts := make(map[string]RuleRef)
+	seen := make(map[string]bool)
+	var suffixes []string
+	for _, listing := range listings {
+		key := asciiRuleKey(listing.Ref.Domain)
+		host, _ := splitRuleKey(key)
+		if strings.HasSuffix(host, ".*") {
+			continue
+		}
+		hosts[key] = listing.Ref
+
+		suffix, icann := publicsuffix.PublicSuffix(strings.TrimPrefix(host, "*."))
+		if icann && !seen[suffix] {
+			seen[suffix] = true
+			suffixes = append(suffixes, suffix)
+		}
+	}
+	sort.Strings(suffixes)
+
+	return hosts, suffixes
+}
+
+// shadowingRule finds a rule that matches before the public suffix wildcard
+// "prefix.*" under one of the suffixes, following the order of
+// hostCandidates. The wildcard never matches there.
+func shadowingRule(hosts map[string]RuleRef, suffixes []string, prefix, rulePath string) (RuleRef, bool) {
+	for _, suffix := range suffixes {
+		u, ok := parseRichUrl("http://" + asciiHost(prefix) + "." + suffix + rulePath)
+		if !ok || u.Tld != suffix {
+			continue
+		}
+		for _, candidate := range hostCandidates(u)[0] {
+			for _, pathPrefix := range pathPrefixes(rulePath) {
+				if shadow, ok := hosts[candidate.Host+pathPrefix]; ok {
+					return shadow, true
+				}
+			}
+		}
+	}
+
+	return RuleRef{}, false
+}
+
+func splitRuleKey(key string) (string, string) {
+	if i := strings.Index(key, "/"); i != -1 {
+		return key[:i], key[i:]
+	}
+
+	return key, ""
+}
+
+// validRuleKey reports whether a rule key can be matched: a lowercase host
+// without scheme or port, wildcards only as a leading "*." or trailing ".*",
+// and a clean path without a trailing slash.
+func validRuleKey(host, rulePath string) bool {
+	bare := strings.TrimSuffix(strings.TrimPrefix(host, "*."), ".*")
+	switch {
+	case bare == "" || host != strings.ToLower(host):
+		return false
+	case strings.ContainsAny(bare, "*:/ \t?#@"):
+		return false
+	case rulePath == "/" || (rulePath != "" && path.Clean(rulePath) != rulePath):
+		return false
+	}
+
+	return true
+}
+
+func ruleCategory(rule DomainRule) string {
+	switch rule.Type {
+	case Email:
+		return "email"
+	case Search:
+		return "search"
+	case Social:
+		return "social"
+	case Unknown:
+		if rule.Paid {
+			return "paid"
+		}
+		return "unknown"
+	}
+
+	return rule.Type.String()
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
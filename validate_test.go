@@ -0,0 +1,90 @@
+package goreferrer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateJsonDomainRules(t *testing.T) {
+	rules := `{
+		"search": {
+			"Zambo": {"domains": ["zambo.com", "*.search.zambo.com", "www.walrus.com"]},
+			"Walrus": {"domains": ["www.walrus.com", "walrus.com/find"], "parameters": ["q"]}
+		},
+		"social": {
+			"Bad": {"domains": ["http://bad.com", "Bad.com", "bad.com/", "bad.*.com", "bad.localdomain", "*.co.uk"]}
+		},
+		"chat": {
+			"Zambo Chat": {"domains": ["chat.zambo.com"]}
+		}
+	}`
+
+	diagnostics, err := ValidateJsonDomainRules(strings.NewReader(rules))
+	assert.NoError(t, err)
+	assert.Equal(t, []Diagnostic{
+		{Kind: UnknownCategory, Rule: RuleRef{Category: "chat"}},
+		{Kind: DuplicateDomain, Rule: RuleRef{"search", "Zambo", "www.walrus.com"}, Conflict: RuleRef{"search", "Walrus", "www.walrus.com"}},
+		{Kind: MissingParameters, Rule: RuleRef{Category: "search", Label: "Zambo"}},
+		{Kind: InvalidDomain, Rule: RuleRef{"social", "Bad", "http://bad.com"}},
+		{Kind: InvalidDomain, Rule: RuleRef{"social", "Bad", "Bad.com"}},
+		{Kind: InvalidDomain, Rule: RuleRef{"social", "Bad", "bad.com/"}},
+		{Kind: InvalidDomain, Rule: RuleRef{"social", "Bad", "bad.*.com"}},
+		{Kind: NoPublicSuffix, Rule: RuleRef{"social", "Bad", "bad.localdomain"}},
+		{Kind: NoPublicSuffix, Rule: RuleRef{"social", "Bad", "*.co.uk"}},
+	}, diagnostics)
+
+	assert.Equal(t, `duplicate domain: search "Zambo" www.walrus.com loses to search "Walrus" www.walrus.com`, diagnostics[1].String())
+	assert.Equal(t, `no public suffix: social "Bad" bad.localdomain`, diagnostics[7].String())
+}
+
+func TestValidateDefaultRules(t *testing.T) {
+	diagnostics, err := ValidateJsonDomainRules(strings.NewReader(defaultRules))
+	assert.NoError(t, err)
+	assert.Empty(t, diagnostics)
+}
+
+func TestValidateDomainRules(t *testing.T) {
+	diagnostics := ValidateDomainRules(map[string]DomainRule{
+		"zambo.com":   {Type: Search, Label: "Zambo", Parameters: []string{"q"}},
+		"*.zambo.com": {Type: Search, Label: "Zambo", Parameters: []string{"q"}},
+		"walrus.*":    {Type: Unknown, Label: "Walrus", Paid: true},
+		"tusk.lan":    {Type: Social, Label: "Tusk"},
+	})
+	assert.Equal(t, []Diagnostic{
+		{Kind: NoPublicSuffix, Rule: RuleRef{"social", "Tusk", "tusk.lan"}},
+	}, diagnostics)
+}
+
+func TestValidateShadowedRule(t *testing.T) {
+	rules := map[string]DomainRule{
+		"walrus.com":   {Type: Social, Label: "Walrus"},
+		"www.walrus.*": {Type: Search, Label: "Walrus Search", Parameters: []string{"q"}},
+		"tusk.com/map": {Type: Social, Label: "Tusk Maps"},
+		"www.tusk.*":   {Type: Search, Label: "Tusk", Parameters: []string{"q"}},
+	}
+	diagnostics := ValidateDomainRules(rules)
+	assert.Equal(t, []Diagnostic{
+		{Kind: ShadowedRule, Rule: RuleRef{"search", "Walrus Search", "www.walrus.*"}, Conflict: RuleRef{"social", "Walrus", "walrus.com"}},
+	}, diagnostics)
+	assert.Equal(t, `shadowed rule: search "Walrus Search" www.walrus.* is never matched where social "Walrus" walrus.com is`, diagnostics[0].String())
+
+	ruleSet := RuleSet{DomainRules: rules}
+	assert.Equal(t, "Walrus", ruleSet.Parse("http://www.walrus.com/?q=boots").Label)
+	assert.Equal(t, "Walrus Search", ruleSet.Parse("http://www.walrus.de/?q=boots").Label)
+	assert.Equal(t, "Tusk", ruleSet.Parse("http://www.tusk.com/?q=boots").Label)
+}
+
+func TestLoadDomainRulesStrict(t *testing.T) {
+	_, err := LoadJsonDomainRulesStrict(strings.NewReader(`{"search": {"Zambo": {"domains": ["zambo.com"]}}, "chat": {}}`))
+	assert.EqualError(t, err, `goreferrer: unknown category: chat (and 1 more problems)`)
+	assert.Len(t, err.(*ValidationError).Diagnostics, 2)
+
+	rules, err := LoadJsonDomainRulesStrict(strings.NewReader(`{"search": {"Zambo": {"domains": ["zambo.com"], "parameters": ["q"]}}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "Zambo", rules["zambo.com"].Label)
+
+	_, err = LoadYamlDomainRulesStrict(strings.NewReader("social:\n  Zambo:\n    domains:\n      - Zambo.com\n"))
+	assert.EqualError(t, err, `goreferrer: invalid domain: social "Zambo" Zambo.com`)
+}
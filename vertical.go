@@ -0,0 +1,49 @@
+package goreferrer
+
+import (
+	"maps"
+	"strings"
+)
+
+// VerticalRule assigns a SearchVertical to search referrers matching its
+// key, which is written like a DomainRules key. Parameters maps
+// "name=value" query parameters, such as Google's "tbm=isch", to the
+// vertical they select, Vertical applies when none of them is present.
+type VerticalRule struct {
+	Vertical   SearchVertical
+	Parameters map[string]SearchVertical
+}
+
+func (r RuleSet) getVerticalRule(u *richUrl) (VerticalRule, bool) {
+	rule, _, exists := findRule(r.VerticalRules, u)
+	return rule, exists
+}
+
+// searchVertical returns the vertical of a search referrer, WebSearch when no
+// rule narrows it down.
+func searchVertical(m ruleMatcher, u *richUrl) SearchVertical {
+	rule, exists := m.getVerticalRule(u)
+	if !exists {
+		return WebSearch
+	}
+
+	if len(rule.Parameters) > 0 {
+		query := u.Query()
+		for _, key := range sortedKeys(rule.Parameters) {
+			name, value, _ := strings.Cut(key, "=")
+			if query.Get(name) == value {
+				return rule.Parameters[key]
+			}
+		}
+	}
+	if rule.Vertical == NotSearch {
+		return WebSearch
+	}
+
+	return rule.Vertical
+}
+
+func (r VerticalRule) clone() VerticalRule {
+	r.Parameters = maps.Clone(r.Parameters)
+	return r
+}
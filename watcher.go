@@ -0,0 +1,137 @@
+package goreferrer
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+)
+
+// ReloadEvent reports a reload attempt of a RuleWatcher. Err is nil when the
+// rules were loaded and published, otherwise the previous rules stay active.
+type ReloadEvent struct {
+	Path  string
+	Rules int
+	Err   error
+}
+
+// RuleWatcher polls a JSON rule file and publishes its domain rules, merged
+// over a base rule set, to an AtomicClassifier whenever the file changes.
+// Rules with invalid domains are always rejected, in Strict mode any
+// diagnostic rejects the file.
+type RuleWatcher struct {
+	Path     string
+	Base     RuleSet
+	Active   *AtomicClassifier
+	Interval time.Duration
+	Strict   bool
+	OnReload func(ReloadEvent)
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+}
+
+const defaultWatchInterval = 5 * time.Second
+
+func NewRuleWatcher(path string, base RuleSet, active *AtomicClassifier) *RuleWatcher {
+	return &RuleWatcher{
+		Path:     path,
+		Base:     base,
+		Active:   active,
+		Interval: defaultWatchInterval,
+	}
+}
+
+// Run reloads the file once, then polls it until ctx is done.
+func (w *RuleWatcher) Run(ctx context.Context) {
+	w.Reload()
+
+	interval := w.Interval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if w.changed() {
+				w.Reload()
+			}
+		}
+	}
+}
+
+// Reload loads, validates and publishes the file. On error the previously
+// published classifier stays active. OnReload is called without holding the
+// watcher's lock, so it may use the watcher.
+func (w *RuleWatcher) Reload() error {
+	event := w.reload()
+	if w.OnReload != nil {
+		w.OnReload(event)
+	}
+
+	return event.Err
+}
+
+func (w *RuleWatcher) reload() ReloadEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	rules, err := w.load()
+	if err == nil {
+		w.Active.Store(NewRuleSetBuilder(w.Base).Merge(RuleSet{DomainRules: rules}).Build())
+	}
+
+	return ReloadEvent{Path: w.Path, Rules: len(rules), Err: err}
+}
+
+func (w *RuleWatcher) load() (map[string]DomainRule, error) {
+	file, err := os.Open(w.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	// Remember the version even if it fails to load, so a broken file is
+	// only reported once rather than on every poll.
+	if info, err := file.Stat(); err == nil {
+		w.modTime, w.size = info.ModTime(), info.Size()
+	}
+
+	if w.Strict {
+		return LoadJsonDomainRulesStrict(file)
+	}
+
+	rules, err := LoadJsonDomainRules(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var invalid []Diagnostic
+	for _, diagnostic := range ValidateDomainRules(rules) {
+		if diagnostic.Kind == InvalidDomain {
+			invalid = append(invalid, diagnostic)
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, &ValidationError{Diagnostics: invalid}
+	}
+
+	return rules, nil
+}
+
+func (w *RuleWatcher) changed() bool {
+	info, err := os.Stat(w.Path)
+	if err != nil {
+		return false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return !info.ModTime().Equal(w.modTime) || info.Size() != w.size
+}
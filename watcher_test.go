@@ -0,0 +1,95 @@
+package goreferrer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeRuleFile(t *testing.T, path, content string, modTime time.Time) {
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestRuleWatcherReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	writeRuleFile(t, path, `{"search": {"Zambo": {"domains": ["zambo.com"], "parameters": ["q"]}}}`, time.Now())
+
+	var events []ReloadEvent
+	var active AtomicClassifier
+	watcher := NewRuleWatcher(path, DefaultRules, &active)
+	watcher.OnReload = func(event ReloadEvent) { events = append(events, event) }
+
+	assert.NoError(t, watcher.Reload())
+	assert.Equal(t, "Zambo", active.Parse("http://zambo.com/?q=boots").Label)
+	assert.Equal(t, "Twitter", active.Parse("https://twitter.com/").Label)
+
+	writeRuleFile(t, path, `{"search": {"Zambo": {"domains": ["zambo.com"]`, time.Now().Add(time.Second))
+	assert.Error(t, watcher.Reload())
+	assert.Equal(t, "Zambo", active.Parse("http://zambo.com/?q=boots").Label)
+
+	writeRuleFile(t, path, `{"search": {"Zambo": {"domains": ["zambo.*.com"]}}}`, time.Now().Add(2*time.Second))
+	assert.EqualError(t, watcher.Reload(), `goreferrer: invalid domain: search "Zambo" zambo.*.com`)
+	assert.Equal(t, "Zambo", active.Parse("http://zambo.com/?q=boots").Label)
+
+	writeRuleFile(t, path, `{"search": {"Zambo": {"domains": ["zambo.com"]}}}`, time.Now().Add(3*time.Second))
+	watcher.Strict = true
+	assert.EqualError(t, watcher.Reload(), `goreferrer: missing parameters: search "Zambo"`)
+	assert.Equal(t, "boots", active.Parse("http://zambo.com/?q=boots").Query)
+
+	assert.Len(t, events, 4)
+	assert.Equal(t, ReloadEvent{Path: path, Rules: 1}, events[0])
+	assert.Error(t, events[1].Err)
+	assert.Error(t, events[2].Err)
+}
+
+func TestRuleWatcherRunPicksUpChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	writeRuleFile(t, path, `{"search": {"Zambo": {"domains": ["zambo.com"]}}}`, time.Now())
+
+	events := make(chan ReloadEvent, 10)
+	var active AtomicClassifier
+	watcher := NewRuleWatcher(path, DefaultRules, &active)
+	watcher.Interval = 10 * time.Millisecond
+	watcher.OnReload = func(event ReloadEvent) { events <- event }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watcher.Run(ctx)
+
+	assert.NoError(t, (<-events).Err)
+	assert.Equal(t, "Zambo", active.Parse("http://zambo.com").Label)
+
+	writeRuleFile(t, path, `{"social": {"Zambo Social": {"domains": ["zambo.com"]}}}`, time.Now().Add(time.Second))
+	select {
+	case event := <-events:
+		assert.NoError(t, event.Err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("change was not picked up")
+	}
+	assert.Equal(t, "Zambo Social", active.Parse("http://zambo.com").Label)
+}
+
+func TestRuleWatcherOnReloadCanUseWatcher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	writeRuleFile(t, path, `{"search": {"Zambo": {"domains": ["zambo.com"]}}}`, time.Now())
+
+	var active AtomicClassifier
+	watcher := NewRuleWatcher(path, DefaultRules, &active)
+	changed := true
+	watcher.OnReload = func(event ReloadEvent) { changed = watcher.changed() }
+
+	done := make(chan error)
+	go func() { done <- watcher.Reload() }()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+		assert.False(t, changed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnReload deadlocked")
+	}
+}